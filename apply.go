@@ -0,0 +1,218 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ColumnSpec declares a new series to be generated by ApplyMulti.
+type ColumnSpec struct {
+	// Name of the new series.
+	Name string
+
+	// Type is used to determine the data type of the new series.
+	// eg. For a string use "". For a int64 use int64(0). What is relevant is the data type and not the value itself.
+	// Any type not natively supported will be stored in a SeriesGeneric.
+	Type interface{}
+}
+
+// ApplyOptions is used to modify the behaviour of ApplyMulti() and Apply().
+type ApplyOptions struct {
+	// Don't apply lock to the dataframe or series.
+	DontLock bool
+
+	// PassNil will call fn for nil values (as NaN) instead of leaving them as nil.
+	// It is only relevant to SeriesFloat64's Apply() and ApplyInPlace().
+	PassNil bool
+}
+
+// ApplyMulti calls fn for each row of df and uses the returned values to generate
+// the new series declared in outputs. This allows multiple related series to be
+// derived in a single pass.
+//
+// The vals provided to fn are keyed by series name. fn must return a map keyed by the
+// names of the declared outputs. A missing key is stored as nil.
+//
+// The returned dataframe contains a copy of the series in df followed by the new series.
+// df itself is not modified.
+//
+// Example:
+//
+//  outputs := []dataframe.ColumnSpec{{"total", float64(0)}, {"discounted", false}}
+//
+//  fn := func(vals map[string]interface{}) (map[string]interface{}, error) {
+//     total := vals["price"].(float64) * float64(vals["qty"].(int64))
+//     return map[string]interface{}{"total": total, "discounted": total > 100}, nil
+//  }
+//
+//  newDF, err := dataframe.ApplyMulti(ctx, df, outputs, fn)
+//
+func ApplyMulti(ctx context.Context, df *DataFrame, outputs []ColumnSpec, fn func(vals map[string]interface{}) (map[string]interface{}, error), options ...ApplyOptions) (*DataFrame, error) {
+
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	names := map[string]struct{}{}
+	for _, aSeries := range df.Series {
+		names[aSeries.Name()] = struct{}{}
+	}
+
+	init := &SeriesInit{Capacity: df.n}
+
+	outputNames := map[string]struct{}{}
+	newSeries := []Series{}
+	for _, spec := range outputs {
+		if _, exists := names[spec.Name]; exists {
+			return nil, fmt.Errorf("series name already exists: %s", spec.Name)
+		}
+		names[spec.Name] = struct{}{}
+		outputNames[spec.Name] = struct{}{}
+		newSeries = append(newSeries, newSeriesFromType(spec.Name, spec.Type, init))
+	}
+
+	for row := 0; row < df.n; row++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		vals := map[string]interface{}{}
+		for _, aSeries := range df.Series {
+			vals[aSeries.Name()] = aSeries.Value(row)
+		}
+
+		out, err := fn(vals)
+		if err != nil {
+			return nil, err
+		}
+
+		for key := range out {
+			if _, exists := outputNames[key]; !exists {
+				return nil, fmt.Errorf("unknown output: %s", key)
+			}
+		}
+
+		for i, spec := range outputs {
+			newSeries[i].Append(out[spec.Name])
+		}
+	}
+
+	newDF := df.Copy()
+	newDF.Series = append(newDF.Series, newSeries...)
+	newDF.n = df.n
+
+	return newDF, nil
+}
+
+// Apply returns a new series by calling fn for each non-nil value.
+// nil values remain nil unless PassNil is set, in which case fn is called with NaN.
+// The new series has the same name as s.
+//
+// Example:
+//
+//  doubled := s.Apply(func(val float64, row int) float64 {
+//     return val * 2
+//  })
+//
+func (s *SeriesFloat64) Apply(fn func(val float64, row int) float64, options ...ApplyOptions) *SeriesFloat64 {
+
+	var opts ApplyOptions
+	if len(options) > 0 {
+		opts = options[0]
+	}
+
+	if !opts.DontLock {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	ns := NewSeriesFloat64(s.name, &SeriesInit{Capacity: len(s.Values)})
+	ns.valFormatter = s.valFormatter
+
+	for row, v := range s.Values {
+		if isNaN(v) && !opts.PassNil {
+			ns.Append(nil)
+			continue
+		}
+		ns.Append(fn(v, row))
+	}
+
+	return ns
+}
+
+// ApplyInPlace calls fn for each non-nil value and replaces the value with the result.
+// nil values remain nil unless PassNil is set, in which case fn is called with NaN.
+// fn can return NaN to set a value to nil.
+func (s *SeriesFloat64) ApplyInPlace(fn func(val float64, row int) float64, options ...ApplyOptions) {
+
+	var opts ApplyOptions
+	if len(options) > 0 {
+		opts = options[0]
+	}
+
+	if !opts.DontLock {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	for row, v := range s.Values {
+		wasNil := isNaN(v)
+		if wasNil && !opts.PassNil {
+			continue
+		}
+
+		newVal := fn(v, row)
+		isNil := isNaN(newVal)
+
+		if wasNil && !isNil {
+			s.nilCount--
+		} else if !wasNil && isNil {
+			s.nilCount++
+		}
+		s.Values[row] = newVal
+	}
+}
+
+// ApplyInPlace calls fn for each non-nil value and replaces the value with the result.
+// nil values remain nil.
+func (s *SeriesInt64) ApplyInPlace(fn func(val int64, row int) int64, options ...ApplyOptions) {
+
+	var opts ApplyOptions
+	if len(options) > 0 {
+		opts = options[0]
+	}
+
+	if !opts.DontLock {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	for row, v := range s.values {
+		if v == nil {
+			continue
+		}
+		s.values[row] = &[]int64{fn(*v, row)}[0]
+	}
+}
+
+// newSeriesFromType creates an empty series that stores values of the same data type as typ.
+func newSeriesFromType(name string, typ interface{}, init *SeriesInit) Series {
+	switch typ.(type) {
+	case float64:
+		return NewSeriesFloat64(name, init)
+	case int64:
+		return NewSeriesInt64(name, init)
+	case string:
+		return NewSeriesString(name, init)
+	case bool:
+		return NewSeriesBool(name, init)
+	case time.Time:
+		return NewSeriesTime(name, init)
+	default:
+		return NewSeriesGeneric(name, typ, init)
+	}
+}
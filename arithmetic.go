@@ -0,0 +1,100 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+import (
+	"math"
+)
+
+// Add returns a new series where each row is the sum of the corresponding rows of s and other.
+// A row is nil if either of the values is nil.
+// other must contain the same number of rows as s.
+func (s *SeriesFloat64) Add(other *SeriesFloat64) (*SeriesFloat64, error) {
+	return s.arithmetic(other, func(a, b float64) float64 { return a + b })
+}
+
+// Subtract returns a new series where each row is the difference of the corresponding rows of s and other.
+// A row is nil if either of the values is nil.
+// other must contain the same number of rows as s.
+func (s *SeriesFloat64) Subtract(other *SeriesFloat64) (*SeriesFloat64, error) {
+	return s.arithmetic(other, func(a, b float64) float64 { return a - b })
+}
+
+// Multiply returns a new series where each row is the product of the corresponding rows of s and other.
+// A row is nil if either of the values is nil.
+// other must contain the same number of rows as s.
+func (s *SeriesFloat64) Multiply(other *SeriesFloat64) (*SeriesFloat64, error) {
+	return s.arithmetic(other, func(a, b float64) float64 { return a * b })
+}
+
+// Divide returns a new series where each row is the quotient of the corresponding rows of s and other.
+// A row is nil if either of the values is nil. Division by zero follows IEEE 754 semantics,
+// producing +Inf, -Inf or NaN (nil).
+// other must contain the same number of rows as s.
+func (s *SeriesFloat64) Divide(other *SeriesFloat64) (*SeriesFloat64, error) {
+	return s.arithmetic(other, func(a, b float64) float64 { return a / b })
+}
+
+// Abs returns a new series containing the absolute value of each row.
+// Nil values remain nil.
+func (s *SeriesFloat64) Abs(options ...Options) *SeriesFloat64 {
+	return s.unary(math.Abs, options...)
+}
+
+// Round returns a new series with each row rounded to the given number of decimal places.
+// Halves are rounded away from zero. A negative number of decimals rounds to the left of the
+// decimal point. eg. Round(-2) rounds to the nearest hundred. Nil values remain nil.
+func (s *SeriesFloat64) Round(decimals int, options ...Options) *SeriesFloat64 {
+	p := math.Pow(10, float64(decimals))
+	return s.unary(func(v float64) float64 {
+		return math.Round(v*p) / p
+	}, options...)
+}
+
+// Floor returns a new series with each row rounded down to the nearest integer.
+// Nil values remain nil.
+func (s *SeriesFloat64) Floor(options ...Options) *SeriesFloat64 {
+	return s.unary(math.Floor, options...)
+}
+
+// Ceil returns a new series with each row rounded up to the nearest integer.
+// Nil values remain nil.
+func (s *SeriesFloat64) Ceil(options ...Options) *SeriesFloat64 {
+	return s.unary(math.Ceil, options...)
+}
+
+func (s *SeriesFloat64) unary(fn func(v float64) float64, options ...Options) *SeriesFloat64 {
+	var opts ApplyOptions
+	if len(options) > 0 {
+		opts.DontLock = options[0].DontLock
+	}
+
+	return s.Apply(func(val float64, row int) float64 {
+		return fn(val)
+	}, opts)
+}
+
+func (s *SeriesFloat64) arithmetic(other *SeriesFloat64, fn func(a, b float64) float64) (*SeriesFloat64, error) {
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if other != s {
+		other.lock.RLock()
+		defer other.lock.RUnlock()
+	}
+
+	if len(other.Values) != len(s.Values) {
+		return nil, ErrMismatchedRows
+	}
+
+	ns := NewSeriesFloat64(s.name, &SeriesInit{Capacity: len(s.Values)})
+	for i, v := range s.Values {
+		ns.Values = append(ns.Values, fn(v, other.Values[i]))
+		if isNaN(ns.Values[i]) {
+			ns.nilCount++
+		}
+	}
+
+	return ns, nil
+}
@@ -0,0 +1,225 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// BinaryVersion is the current version of the binary format generated by WriteBinary.
+const BinaryVersion = 1
+
+// binaryMagic identifies data generated by WriteBinary.
+var binaryMagic = [4]byte{'D', 'F', 'G', 'O'}
+
+const binaryFlagGzip = 1 << 0
+
+// BinaryOptions is used to modify the behaviour of WriteBinary().
+type BinaryOptions struct {
+	// Don't apply read lock to the dataframe.
+	DontLock bool
+
+	// Compress will gzip the data.
+	Compress bool
+}
+
+// binaryFrame is the gob encoded representation of a dataframe.
+type binaryFrame struct {
+	NRows  int
+	Series []binarySeries
+}
+
+// binarySeries is the gob encoded representation of a series.
+// Only the slice corresponding to Type is populated.
+type binarySeries struct {
+	Name    string
+	Type    string
+	Nil     []bool
+	Float64 []float64
+	Int64   []int64
+	String  []string
+	Time    []time.Time
+	Bool    []bool
+}
+
+// WriteBinary writes df to w in a compact binary format that can be read back using ReadBinary.
+// The data is prefixed with a header containing the version of the format so that it can evolve safely.
+// Only float64, int64, string, time and bool series are supported.
+//
+// Example:
+//
+//  err := dataframe.WriteBinary(ctx, f, df, dataframe.BinaryOptions{Compress: true})
+//
+func WriteBinary(ctx context.Context, w io.Writer, df *DataFrame, options ...BinaryOptions) error {
+
+	var opts BinaryOptions
+	if len(options) > 0 {
+		opts = options[0]
+	}
+
+	if !opts.DontLock {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	bf := binaryFrame{NRows: df.n}
+
+	for _, aSeries := range df.Series {
+		bs := binarySeries{
+			Name: aSeries.Name(),
+			Type: aSeries.Type(),
+			Nil:  make([]bool, df.n),
+		}
+
+		for row := 0; row < df.n; row++ {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			val := aSeries.Value(row)
+			bs.Nil[row] = val == nil
+
+			switch aSeries.(type) {
+			case *SeriesFloat64:
+				v, _ := val.(float64)
+				bs.Float64 = append(bs.Float64, v)
+			case *SeriesInt64:
+				v, _ := val.(int64)
+				bs.Int64 = append(bs.Int64, v)
+			case *SeriesString:
+				v, _ := val.(string)
+				bs.String = append(bs.String, v)
+			case *SeriesTime:
+				v, _ := val.(time.Time)
+				bs.Time = append(bs.Time, v)
+			case *SeriesBool:
+				v, _ := val.(bool)
+				bs.Bool = append(bs.Bool, v)
+			default:
+				return fmt.Errorf("unsupported series type: %s", aSeries.Type())
+			}
+		}
+
+		bf.Series = append(bf.Series, bs)
+	}
+
+	var flags byte
+	if opts.Compress {
+		flags = flags | binaryFlagGzip
+	}
+
+	header := append(binaryMagic[:], BinaryVersion, flags)
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+
+	if opts.Compress {
+		zw := gzip.NewWriter(w)
+		if err := gob.NewEncoder(zw).Encode(bf); err != nil {
+			return err
+		}
+		return zw.Close()
+	}
+
+	return gob.NewEncoder(w).Encode(bf)
+}
+
+// ReadBinary reads a dataframe that was written using WriteBinary.
+// Compressed data is detected automatically.
+// An error is returned if the data was generated by an unsupported version of the format.
+func ReadBinary(ctx context.Context, r io.Reader) (*DataFrame, error) {
+
+	br := bufio.NewReader(r)
+
+	var header [6]byte
+	if _, err := io.ReadFull(br, header[:]); err != nil {
+		return nil, errors.New("invalid binary format: header missing")
+	}
+
+	if header[0] != binaryMagic[0] || header[1] != binaryMagic[1] || header[2] != binaryMagic[2] || header[3] != binaryMagic[3] {
+		return nil, errors.New("invalid binary format: unrecognized header")
+	}
+
+	version, flags := header[4], header[5]
+	if version != BinaryVersion {
+		return nil, fmt.Errorf("unsupported binary format version: %d (supported: %d)", version, BinaryVersion)
+	}
+
+	var src io.Reader = br
+	if flags&binaryFlagGzip != 0 {
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		src = zr
+	}
+
+	var bf binaryFrame
+	if err := gob.NewDecoder(src).Decode(&bf); err != nil {
+		return nil, err
+	}
+
+	init := &SeriesInit{Capacity: bf.NRows}
+
+	seriess := []Series{}
+	for _, bs := range bf.Series {
+
+		if len(bs.Nil) != bf.NRows {
+			return nil, errors.New("invalid binary format: inconsistent number of rows")
+		}
+
+		var (
+			s    Series
+			vals func(row int) interface{}
+			n    int
+		)
+
+		switch bs.Type {
+		case "float64":
+			s, n = NewSeriesFloat64(bs.Name, init), len(bs.Float64)
+			vals = func(row int) interface{} { return bs.Float64[row] }
+		case "int64":
+			s, n = NewSeriesInt64(bs.Name, init), len(bs.Int64)
+			vals = func(row int) interface{} { return bs.Int64[row] }
+		case "string":
+			s, n = NewSeriesString(bs.Name, init), len(bs.String)
+			vals = func(row int) interface{} { return bs.String[row] }
+		case "time":
+			s, n = NewSeriesTime(bs.Name, init), len(bs.Time)
+			vals = func(row int) interface{} { return bs.Time[row] }
+		case "bool":
+			s, n = NewSeriesBool(bs.Name, init), len(bs.Bool)
+			vals = func(row int) interface{} { return bs.Bool[row] }
+		default:
+			return nil, fmt.Errorf("invalid binary format: unsupported series type: %s", bs.Type)
+		}
+
+		if n != bf.NRows {
+			return nil, errors.New("invalid binary format: inconsistent number of rows")
+		}
+
+		for row := 0; row < bf.NRows; row++ {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			if bs.Nil[row] {
+				s.Append(nil)
+			} else {
+				s.Append(vals(row))
+			}
+		}
+
+		seriess = append(seriess, s)
+	}
+
+	return NewDataFrame(seriess...), nil
+}
@@ -0,0 +1,295 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CastColumn converts the series identified by name into a new series of targetType.
+// targetType can be "float64", "int64", "string", "bool" or "time".
+//
+// Strings are parsed, numbers are converted (a float64 must be a whole number to be converted to an int64),
+// 0 and 1 are converted to bool and an int64 is treated as a unix timestamp (in seconds) when converting to time.
+// Times are converted to strings using the RFC3339 format. Nil values remain nil.
+//
+// onError is called for each value that can't be converted. It can return a replacement value (which can be nil)
+// or an error to abort. The replacement value is converted to targetType in the same manner.
+// If onError is nil, the first value that can't be converted aborts the operation with an error.
+// The dataframe is not modified if the operation is aborted.
+//
+// Example:
+//
+//  err := df.CastColumn("price", "float64", func(row int, raw interface{}) (interface{}, error) {
+//     if raw == "N/A" {
+//        return nil, nil
+//     }
+//     return nil, fmt.Errorf("bad price at row %d: %v", row, raw)
+//  })
+//
+func (df *DataFrame) CastColumn(name, targetType string, onError func(row int, raw interface{}) (interface{}, error), options ...Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		df.lock.Lock()
+		defer df.lock.Unlock()
+	}
+
+	var typ interface{}
+	switch targetType {
+	case "float64":
+		typ = float64(0)
+	case "int64":
+		typ = int64(0)
+	case "string":
+		typ = ""
+	case "bool":
+		typ = false
+	case "time":
+		typ = time.Time{}
+	default:
+		return fmt.Errorf("unsupported target type: %s", targetType)
+	}
+
+	idx, err := df.NameToColumn(name)
+	if err != nil {
+		return errors.New(err.Error() + ": " + name)
+	}
+
+	ns := newSeriesFromType(name, typ, &SeriesInit{Capacity: df.n})
+
+	for row := 0; row < df.n; row++ {
+		raw := df.Series[idx].Value(row)
+
+		val, err := castValue(raw, targetType)
+		if err != nil {
+			if onError == nil {
+				return fmt.Errorf("%s. row: %d field: %s", err.Error(), row, name)
+			}
+
+			replacement, err := onError(row, raw)
+			if err != nil {
+				return err
+			}
+
+			val, err = castValue(replacement, targetType)
+			if err != nil {
+				return fmt.Errorf("%s. row: %d field: %s", err.Error(), row, name)
+			}
+		}
+
+		ns.Append(val)
+	}
+
+	df.Series[idx] = ns
+
+	return nil
+}
+
+// castValue converts v into the data type identified by targetType.
+func castValue(v interface{}, targetType string) (interface{}, error) {
+
+	if v == nil {
+		return nil, nil
+	}
+
+	switch targetType {
+	case "float64":
+		switch T := v.(type) {
+		case float64:
+			return T, nil
+		case int64:
+			return float64(T), nil
+		case bool:
+			if T {
+				return float64(1), nil
+			}
+			return float64(0), nil
+		case string:
+			f, err := strconv.ParseFloat(strings.TrimSpace(T), 64)
+			if err == nil {
+				return f, nil
+			}
+		}
+	case "int64":
+		switch T := v.(type) {
+		case int64:
+			return T, nil
+		case float64:
+			if T == math.Trunc(T) && T >= math.MinInt64 && T < math.MaxInt64 {
+				return int64(T), nil
+			}
+		case bool:
+			if T {
+				return int64(1), nil
+			}
+			return int64(0), nil
+		case string:
+			i, err := strconv.ParseInt(strings.TrimSpace(T), 10, 64)
+			if err == nil {
+				return i, nil
+			}
+		}
+	case "string":
+		switch T := v.(type) {
+		case string:
+			return T, nil
+		case time.Time:
+			return T.Format(time.RFC3339), nil
+		default:
+			return fmt.Sprintf("%v", v), nil
+		}
+	case "bool":
+		switch T := v.(type) {
+		case bool:
+			return T, nil
+		case int64:
+			if T == 0 || T == 1 {
+				return T == 1, nil
+			}
+		case float64:
+			if T == 0 || T == 1 {
+				return T == 1, nil
+			}
+		case string:
+			b, err := strconv.ParseBool(strings.TrimSpace(T))
+			if err == nil {
+				return b, nil
+			}
+		}
+	case "time":
+		switch T := v.(type) {
+		case time.Time:
+			return T, nil
+		case int64:
+			return time.Unix(T, 0), nil
+		case string:
+			t, err := time.Parse(time.RFC3339, strings.TrimSpace(T))
+			if err == nil {
+				return t, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("can't convert %v to %s", v, targetType)
+}
+
+// RoundingMode determines how a float64 is converted to an int64.
+type RoundingMode int
+
+const (
+	// RoundTruncate removes the fractional part.
+	RoundTruncate RoundingMode = 0
+	// RoundHalfAwayFromZero rounds to the nearest integer, with halves rounded away from zero.
+	RoundHalfAwayFromZero RoundingMode = 1
+	// RoundFloor rounds down to the nearest integer.
+	RoundFloor RoundingMode = 2
+	// RoundCeil rounds up to the nearest integer.
+	RoundCeil RoundingMode = 3
+)
+
+// CastOptions is used to modify the behaviour of ToSeriesFloat64() for a SeriesString.
+type CastOptions struct {
+	// Don't apply lock to the series.
+	DontLock bool
+
+	// NilOnError will store nil for values that can't be converted instead of returning an error.
+	NilOnError bool
+}
+
+// ToSeriesFloat64 returns a new SeriesFloat64 containing the values of s converted to float64.
+// Nil values remain nil.
+func (s *SeriesInt64) ToSeriesFloat64(options ...Options) *SeriesFloat64 {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	ns := NewSeriesFloat64(s.name, &SeriesInit{Capacity: len(s.values)})
+	for _, v := range s.values {
+		if v == nil {
+			ns.Append(nil)
+			continue
+		}
+		ns.Append(float64(*v))
+	}
+
+	return ns
+}
+
+// ToSeriesInt64 returns a new SeriesInt64 containing the values of s converted to int64 using mode.
+// Nil values remain nil. An error is returned if a value is out of the range of an int64.
+func (s *SeriesFloat64) ToSeriesInt64(mode RoundingMode, options ...Options) (*SeriesInt64, error) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	var round func(float64) float64
+	switch mode {
+	case RoundTruncate:
+		round = math.Trunc
+	case RoundHalfAwayFromZero:
+		round = math.Round
+	case RoundFloor:
+		round = math.Floor
+	case RoundCeil:
+		round = math.Ceil
+	default:
+		return nil, errors.New("invalid rounding mode")
+	}
+
+	ns := NewSeriesInt64(s.name, &SeriesInit{Capacity: len(s.Values)})
+	for row, v := range s.Values {
+		if isNaN(v) {
+			ns.Append(nil)
+			continue
+		}
+
+		r := round(v)
+		if r < math.MinInt64 || r >= math.MaxInt64 {
+			return nil, fmt.Errorf("can't convert %v to int64. row: %d", v, row)
+		}
+		ns.Append(int64(r))
+	}
+
+	return ns, nil
+}
+
+// ToSeriesFloat64 returns a new SeriesFloat64 containing the values of s parsed as float64.
+// Nil values remain nil. An error is returned if a value can't be parsed, unless NilOnError is set.
+func (s *SeriesString) ToSeriesFloat64(options ...CastOptions) (*SeriesFloat64, error) {
+
+	var opts CastOptions
+	if len(options) > 0 {
+		opts = options[0]
+	}
+
+	if !opts.DontLock {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	ns := NewSeriesFloat64(s.name, &SeriesInit{Capacity: len(s.values)})
+	for row, v := range s.values {
+		if v == nil {
+			ns.Append(nil)
+			continue
+		}
+
+		val, err := castValue(*v, "float64")
+		if err != nil {
+			if opts.NilOnError {
+				ns.Append(nil)
+				continue
+			}
+			return nil, fmt.Errorf("%s. row: %d", err.Error(), row)
+		}
+		ns.Append(val)
+	}
+
+	return ns, nil
+}
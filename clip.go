@@ -0,0 +1,124 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// Clip limits the values of the series to the interval [min, max]. Values below min
+// are set to min and values above max are set to max.
+// A NaN bound signifies that there is no bound on that side.
+// The number of values that were modified is returned. nil values are never modified.
+func (s *SeriesFloat64) Clip(min, max float64, options ...Options) (int, error) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	return s.clip(min, max)
+}
+
+// Winsorize limits extreme values of the series by clipping them to the values at
+// the lower and upper quantiles (between 0 and 1) of the non-nil values.
+// eg. Winsorize(0.05, 0.95) sets values below the 5th percentile to the 5th percentile
+// and values above the 95th percentile to the 95th percentile.
+// Quantiles are calculated using linear interpolation between the closest ranks.
+// The number of values that were modified is returned. nil values are never modified.
+func (s *SeriesFloat64) Winsorize(lower, upper float64, options ...Options) (int, error) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	if lower < 0 || upper > 1 || lower > upper {
+		return 0, errors.New("quantiles must satisfy 0 <= lower <= upper <= 1")
+	}
+
+	sorted := make([]float64, 0, len(s.Values))
+	for _, v := range s.Values {
+		if !isNaN(v) {
+			sorted = append(sorted, v)
+		}
+	}
+
+	if len(sorted) == 0 {
+		return 0, nil
+	}
+
+	sort.Float64s(sorted)
+
+	return s.clip(quantile(sorted, lower), quantile(sorted, upper))
+}
+
+// clip limits the values of the series to the interval [min, max].
+// s must be locked before calling clip.
+func (s *SeriesFloat64) clip(min, max float64) (int, error) {
+
+	if !isNaN(min) && !isNaN(max) && min > max {
+		return 0, errors.New("min must not be greater than max")
+	}
+
+	var count int
+	for i, v := range s.Values {
+		if isNaN(v) {
+			continue
+		}
+
+		if !isNaN(min) && v < min {
+			s.Values[i] = min
+			count++
+		} else if !isNaN(max) && v > max {
+			s.Values[i] = max
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// Clip returns a new series with the values limited to the interval [min, max]. Values below min
+// are set to min and values above max are set to max. s is not modified.
+// A nil bound signifies that there is no bound on that side. nil values remain nil.
+func (s *SeriesInt64) Clip(min, max *int64, options ...Options) (*SeriesInt64, error) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	if min != nil && max != nil && *min > *max {
+		return nil, errors.New("min must not be greater than max")
+	}
+
+	ns := NewSeriesInt64(s.name, &SeriesInit{Capacity: len(s.values)})
+	ns.valFormatter = s.valFormatter
+	ns.nilCount = s.nilCount
+
+	for _, v := range s.values {
+		switch {
+		case v == nil:
+			ns.values = append(ns.values, nil)
+		case min != nil && *v < *min:
+			ns.values = append(ns.values, &[]int64{*min}[0])
+		case max != nil && *v > *max:
+			ns.values = append(ns.values, &[]int64{*max}[0])
+		default:
+			ns.values = append(ns.values, &[]int64{*v}[0])
+		}
+	}
+
+	return ns, nil
+}
+
+// quantile returns the q-th quantile of the sorted values using
+// linear interpolation between the closest ranks.
+func quantile(sorted []float64, q float64) float64 {
+
+	pos := q * float64(len(sorted)-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+
+	return sorted[lo] + (pos-float64(lo))*(sorted[hi]-sorted[lo])
+}
@@ -0,0 +1,202 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrMismatchedRows signifies that two Series or Dataframes do not contain
+// the same number of rows.
+var ErrMismatchedRows = errors.New("mismatched number of rows")
+
+// Equal compares each row with other and returns a mask that is true where the values are equal.
+// other can be a float64 (or any integer type) or a *SeriesFloat64 with the same number of rows.
+// A row in the mask is nil if either of the compared values is nil.
+func (s *SeriesFloat64) Equal(other interface{}) (*SeriesBool, error) {
+	return s.compare(other, func(a, b float64) bool { return a == b })
+}
+
+// NotEqual compares each row with other and returns a mask that is true where the values are not equal.
+// other can be a float64 (or any integer type) or a *SeriesFloat64 with the same number of rows.
+// A row in the mask is nil if either of the compared values is nil.
+func (s *SeriesFloat64) NotEqual(other interface{}) (*SeriesBool, error) {
+	return s.compare(other, func(a, b float64) bool { return a != b })
+}
+
+// GreaterThan compares each row with other and returns a mask that is true where the value is greater than other.
+// other can be a float64 (or any integer type) or a *SeriesFloat64 with the same number of rows.
+// A row in the mask is nil if either of the compared values is nil.
+func (s *SeriesFloat64) GreaterThan(other interface{}) (*SeriesBool, error) {
+	return s.compare(other, func(a, b float64) bool { return a > b })
+}
+
+// GreaterThanOrEqual compares each row with other and returns a mask that is true where the value is greater than or equal to other.
+// other can be a float64 (or any integer type) or a *SeriesFloat64 with the same number of rows.
+// A row in the mask is nil if either of the compared values is nil.
+func (s *SeriesFloat64) GreaterThanOrEqual(other interface{}) (*SeriesBool, error) {
+	return s.compare(other, func(a, b float64) bool { return a >= b })
+}
+
+// LessThan compares each row with other and returns a mask that is true where the value is less than other.
+// other can be a float64 (or any integer type) or a *SeriesFloat64 with the same number of rows.
+// A row in the mask is nil if either of the compared values is nil.
+func (s *SeriesFloat64) LessThan(other interface{}) (*SeriesBool, error) {
+	return s.compare(other, func(a, b float64) bool { return a < b })
+}
+
+// LessThanOrEqual compares each row with other and returns a mask that is true where the value is less than or equal to other.
+// other can be a float64 (or any integer type) or a *SeriesFloat64 with the same number of rows.
+// A row in the mask is nil if either of the compared values is nil.
+func (s *SeriesFloat64) LessThanOrEqual(other interface{}) (*SeriesBool, error) {
+	return s.compare(other, func(a, b float64) bool { return a <= b })
+}
+
+func (s *SeriesFloat64) compare(other interface{}, fn func(a, b float64) bool) (*SeriesBool, error) {
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	var (
+		scalar float64
+		vals   []float64
+	)
+
+	switch o := other.(type) {
+	case *SeriesFloat64:
+		if o != s {
+			o.lock.RLock()
+			defer o.lock.RUnlock()
+		}
+		if len(o.Values) != len(s.Values) {
+			return nil, ErrMismatchedRows
+		}
+		vals = o.Values
+	case float64:
+		scalar = o
+	case float32:
+		scalar = float64(o)
+	case int:
+		scalar = float64(o)
+	case int8:
+		scalar = float64(o)
+	case int16:
+		scalar = float64(o)
+	case int32:
+		scalar = float64(o)
+	case int64:
+		scalar = float64(o)
+	default:
+		return nil, fmt.Errorf("can't compare float64 with %T", other)
+	}
+
+	mask := NewSeriesBool(s.name, &SeriesInit{Capacity: len(s.Values)})
+	for row, v := range s.Values {
+		b := scalar
+		if vals != nil {
+			b = vals[row]
+		}
+
+		if isNaN(v) || isNaN(b) {
+			mask.Append(nil)
+			continue
+		}
+		mask.Append(fn(v, b))
+	}
+
+	return mask, nil
+}
+
+// Equal compares each row with other and returns a mask that is true where the values are equal.
+// other can be an int64 (or any integer type) or a *SeriesInt64 with the same number of rows.
+// A row in the mask is nil if either of the compared values is nil.
+func (s *SeriesInt64) Equal(other interface{}) (*SeriesBool, error) {
+	return s.compare(other, func(a, b int64) bool { return a == b })
+}
+
+// NotEqual compares each row with other and returns a mask that is true where the values are not equal.
+// other can be an int64 (or any integer type) or a *SeriesInt64 with the same number of rows.
+// A row in the mask is nil if either of the compared values is nil.
+func (s *SeriesInt64) NotEqual(other interface{}) (*SeriesBool, error) {
+	return s.compare(other, func(a, b int64) bool { return a != b })
+}
+
+// GreaterThan compares each row with other and returns a mask that is true where the value is greater than other.
+// other can be an int64 (or any integer type) or a *SeriesInt64 with the same number of rows.
+// A row in the mask is nil if either of the compared values is nil.
+func (s *SeriesInt64) GreaterThan(other interface{}) (*SeriesBool, error) {
+	return s.compare(other, func(a, b int64) bool { return a > b })
+}
+
+// GreaterThanOrEqual compares each row with other and returns a mask that is true where the value is greater than or equal to other.
+// other can be an int64 (or any integer type) or a *SeriesInt64 with the same number of rows.
+// A row in the mask is nil if either of the compared values is nil.
+func (s *SeriesInt64) GreaterThanOrEqual(other interface{}) (*SeriesBool, error) {
+	return s.compare(other, func(a, b int64) bool { return a >= b })
+}
+
+// LessThan compares each row with other and returns a mask that is true where the value is less than other.
+// other can be an int64 (or any integer type) or a *SeriesInt64 with the same number of rows.
+// A row in the mask is nil if either of the compared values is nil.
+func (s *SeriesInt64) LessThan(other interface{}) (*SeriesBool, error) {
+	return s.compare(other, func(a, b int64) bool { return a < b })
+}
+
+// LessThanOrEqual compares each row with other and returns a mask that is true where the value is less than or equal to other.
+// other can be an int64 (or any integer type) or a *SeriesInt64 with the same number of rows.
+// A row in the mask is nil if either of the compared values is nil.
+func (s *SeriesInt64) LessThanOrEqual(other interface{}) (*SeriesBool, error) {
+	return s.compare(other, func(a, b int64) bool { return a <= b })
+}
+
+func (s *SeriesInt64) compare(other interface{}, fn func(a, b int64) bool) (*SeriesBool, error) {
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	var (
+		scalar *int64
+		vals   []*int64
+	)
+
+	switch o := other.(type) {
+	case *SeriesInt64:
+		if o != s {
+			o.lock.RLock()
+			defer o.lock.RUnlock()
+		}
+		if len(o.values) != len(s.values) {
+			return nil, ErrMismatchedRows
+		}
+		vals = o.values
+	case int64:
+		scalar = &o
+	case int:
+		scalar = &[]int64{int64(o)}[0]
+	case int8:
+		scalar = &[]int64{int64(o)}[0]
+	case int16:
+		scalar = &[]int64{int64(o)}[0]
+	case int32:
+		scalar = &[]int64{int64(o)}[0]
+	default:
+		return nil, fmt.Errorf("can't compare int64 with %T", other)
+	}
+
+	mask := NewSeriesBool(s.name, &SeriesInit{Capacity: len(s.values)})
+	for row, v := range s.values {
+		b := scalar
+		if vals != nil {
+			b = vals[row]
+		}
+
+		if v == nil || b == nil {
+			mask.Append(nil)
+			continue
+		}
+		mask.Append(fn(*v, *b))
+	}
+
+	return mask, nil
+}
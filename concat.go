@@ -0,0 +1,168 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Axis is the direction in which dataframes are combined by Concat().
+type Axis int
+
+const (
+	// VerticalAxis stacks the rows of the dataframes.
+	VerticalAxis Axis = 0
+
+	// HorizontalAxis places the series of the dataframes side by side.
+	HorizontalAxis Axis = 1
+)
+
+// ConcatOptions is used to modify the behaviour of Concat().
+type ConcatOptions struct {
+	// Don't apply read lock to the dataframes.
+	DontLock bool
+
+	// FillMissing allows dataframes with different series to be stacked vertically.
+	// A dataframe that doesn't contain a series contributes nil values to that series.
+	// It is not relevant for HorizontalAxis.
+	FillMissing bool
+}
+
+// Concat combines multiple dataframes into a new dataframe.
+//
+// For VerticalAxis, the rows of each dataframe are stacked in order. The series are matched by name
+// and ordered by their first appearance. Each dataframe must contain the same series unless FillMissing
+// is set. Series with the same name must be of the same type, otherwise an error is returned.
+// No type conversion is performed.
+//
+// For HorizontalAxis, the series of each dataframe are placed side by side in order. Each dataframe must
+// contain the same number of rows and the names of the series must be unique.
+//
+// Example:
+//
+//  df, err := dataframe.Concat(ctx, []*dataframe.DataFrame{jan, feb, mar}, dataframe.VerticalAxis)
+//
+func Concat(ctx context.Context, dfs []*DataFrame, axis Axis, options ...ConcatOptions) (*DataFrame, error) {
+
+	var opts ConcatOptions
+	if len(options) > 0 {
+		opts = options[0]
+	}
+
+	if len(dfs) == 0 {
+		return nil, errors.New("no dataframes provided")
+	}
+
+	if !opts.DontLock {
+		locked := map[*DataFrame]struct{}{}
+		for _, df := range dfs {
+			if _, exists := locked[df]; exists {
+				continue
+			}
+			locked[df] = struct{}{}
+			df.lock.RLock()
+			defer df.lock.RUnlock()
+		}
+	}
+
+	switch axis {
+	case VerticalAxis:
+		return concatVertical(ctx, dfs, opts)
+	case HorizontalAxis:
+		return concatHorizontal(ctx, dfs)
+	default:
+		return nil, errors.New("unknown axis")
+	}
+}
+
+func concatVertical(ctx context.Context, dfs []*DataFrame, opts ConcatOptions) (*DataFrame, error) {
+
+	// Determine series and check types
+	names := []string{}
+	templates := map[string]Series{}
+
+	for _, df := range dfs {
+		for _, aSeries := range df.Series {
+			name := aSeries.Name()
+			template, exists := templates[name]
+			if !exists {
+				names = append(names, name)
+				templates[name] = aSeries
+				continue
+			}
+
+			if template.Type() != aSeries.Type() {
+				return nil, fmt.Errorf("series type mismatch: %s (%s vs %s)", name, template.Type(), aSeries.Type())
+			}
+		}
+	}
+
+	if !opts.FillMissing {
+		for _, df := range dfs {
+			if len(df.Series) != len(names) {
+				return nil, errors.New("dataframes must contain the same series")
+			}
+		}
+	}
+
+	seriess := []Series{}
+	for _, name := range names {
+		ns, err := subset(ctx, templates[name], nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, df := range dfs {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			idx, err := df.NameToColumn(name)
+			if err != nil {
+				// Missing series
+				for row := 0; row < df.n; row++ {
+					ns.Append(nil)
+				}
+				continue
+			}
+
+			for row := 0; row < df.n; row++ {
+				ns.Append(df.Series[idx].Value(row))
+			}
+		}
+
+		seriess = append(seriess, ns)
+	}
+
+	return NewDataFrame(seriess...), nil
+}
+
+func concatHorizontal(ctx context.Context, dfs []*DataFrame) (*DataFrame, error) {
+
+	names := map[string]struct{}{}
+	seriess := []Series{}
+
+	for _, df := range dfs {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if df.n != dfs[0].n {
+			return nil, ErrMismatchedRows
+		}
+
+		for _, aSeries := range df.Series {
+			name := aSeries.Name()
+			if _, exists := names[name]; exists {
+				return nil, fmt.Errorf("series name must be unique: %s", name)
+			}
+			names[name] = struct{}{}
+
+			seriess = append(seriess, aSeries.Copy())
+		}
+	}
+
+	return NewDataFrame(seriess...), nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+// CumulativeOptions is used to modify the behaviour of CumSum() and CumProd().
+type CumulativeOptions struct {
+	// Don't apply read lock to the series.
+	DontLock bool
+
+	// KeepNil will output nil for rows that are nil instead of the running value.
+	KeepNil bool
+}
+
+// CumSum returns a new series containing the running total of the series.
+// nil values are treated as 0. By default, a nil row outputs the running total
+// up to that row. If KeepNil is set, a nil row outputs nil.
+// Leading nil values (before the first non-nil value) always remain nil.
+func (s *SeriesFloat64) CumSum(options ...CumulativeOptions) *SeriesFloat64 {
+	return s.cumulative(0, func(acc, v float64) float64 { return acc + v }, options...)
+}
+
+// CumProd returns a new series containing the running product of the series.
+// nil values are treated as 1. By default, a nil row outputs the running product
+// up to that row. If KeepNil is set, a nil row outputs nil.
+// Leading nil values (before the first non-nil value) always remain nil.
+func (s *SeriesFloat64) CumProd(options ...CumulativeOptions) *SeriesFloat64 {
+	return s.cumulative(1, func(acc, v float64) float64 { return acc * v }, options...)
+}
+
+// cumulative applies fn to a running accumulator starting at identity.
+func (s *SeriesFloat64) cumulative(identity float64, fn func(acc, v float64) float64, options ...CumulativeOptions) *SeriesFloat64 {
+
+	var opts CumulativeOptions
+	if len(options) > 0 {
+		opts = options[0]
+	}
+
+	if !opts.DontLock {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	ns := NewSeriesFloat64(s.name, &SeriesInit{Capacity: len(s.Values)})
+
+	acc := identity
+	started := false
+
+	for _, v := range s.Values {
+		if isNaN(v) {
+			if !started || opts.KeepNil {
+				ns.Append(nil)
+			} else {
+				ns.Append(acc)
+			}
+			continue
+		}
+
+		started = true
+		acc = fn(acc, v)
+		ns.Append(acc)
+	}
+
+	return ns
+}
@@ -68,19 +68,22 @@ type ValuesOptions struct {
 	// Step represents by how much each iteration should step by.
 	// It can be negative to represent iterating in backwards direction.
 	// InitialRow should be adjusted to NRows()-1 if Step is negative.
-	// If Step is 0, the function will panic.
+	// If Step is 0, 1 is used.
 	Step int
 
 	// Don't apply read lock. This is useful if you intend to Write lock
 	// the entire dataframe.
 	DontReadLock bool
+
+	// SkipNil will skip rows that contain a nil value.
+	SkipNil bool
 }
 
 // Values will return an iterator that can be used to iterate through all the values.
 //
 // Example:
 //
-//  iterator := df.Values(dataframe.ValuesOptions{0, 1, true})
+//  iterator := df.Values(dataframe.ValuesOptions{InitialRow: 0, Step: 1, DontReadLock: true})
 //
 //  df.Lock()
 //  for {
@@ -94,43 +97,38 @@ type ValuesOptions struct {
 //
 func (df *DataFrame) Values(options ...ValuesOptions) func() (*int, map[interface{}]interface{}) {
 
-	var row int
-	var step int = 1
-
-	var dontReadLock bool
-
+	var skipNil bool
 	if len(options) > 0 {
-		dontReadLock = options[0].DontReadLock
-
-		row = options[0].InitialRow
-		step = options[0].Step
-		if step == 0 {
-			panic("Step can not be zero")
-		}
+		skipNil = options[0].SkipNil
 	}
 
+	next := newIterator(df.lock.RLocker(), func() int { return df.n }, options...)
+
 	return func() (*int, map[interface{}]interface{}) {
-		if !dontReadLock {
-			df.lock.RLock()
-			defer df.lock.RUnlock()
-		}
+		for {
+			row, _, unlock := next()
+			if row == nil {
+				return nil, nil
+			}
 
-		if row > df.n-1 || row < 0 {
-			// Don't iterate further
-			return nil, nil
-		}
+			out := map[interface{}]interface{}{}
 
-		out := map[interface{}]interface{}{}
+			var containsNil bool
+			for idx, aSeries := range df.Series {
+				val := aSeries.Value(*row)
+				if val == nil {
+					containsNil = true
+				}
+				out[aSeries.Name()] = val
+				out[idx] = val
+			}
+			unlock()
 
-		for idx, aSeries := range df.Series {
-			val := aSeries.Value(row)
-			out[aSeries.Name()] = val
-			out[idx] = val
+			if skipNil && containsNil {
+				continue
+			}
+			return row, out
 		}
-
-		row = row + step
-
-		return &[]int{row - step}[0], out
 	}
 }
 
@@ -239,6 +237,81 @@ func (df *DataFrame) Remove(row int) {
 	df.n--
 }
 
+// RemoveRows deletes multiple rows in a single pass.
+// rows does not need to be sorted. An error is returned (without modifying the dataframe)
+// if rows contains a duplicate or out of range row.
+func (df *DataFrame) RemoveRows(rows []int, options ...Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		df.lock.Lock()
+		defer df.lock.Unlock()
+	}
+
+	sorted, err := sortedRows(rows, df.n)
+	if err != nil {
+		return err
+	}
+
+	for i := range df.Series {
+		if rr, ok := df.Series[i].(interface {
+			RemoveRows([]int, ...Options) error
+		}); ok {
+			if err := rr.RemoveRows(sorted); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Remove from the end so that the positions of earlier rows are not affected
+		for j := len(sorted) - 1; j >= 0; j-- {
+			df.Series[i].Remove(sorted[j])
+		}
+	}
+	df.n = df.n - len(sorted)
+
+	return nil
+}
+
+// DropNil removes every row that contains a nil value in any of the series named in cols.
+// If cols is nil, all series are checked. The same rows are removed from every series.
+// The number of rows removed is returned.
+func (df *DataFrame) DropNil(cols []string, options ...Options) (int, error) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		df.lock.Lock()
+		defer df.lock.Unlock()
+	}
+
+	seriess := df.Series
+	if cols != nil {
+		seriess = []Series{}
+		for _, name := range cols {
+			idx, err := df.NameToColumn(name)
+			if err != nil {
+				return 0, err
+			}
+			seriess = append(seriess, df.Series[idx])
+		}
+	}
+
+	rows := []int{}
+	for row := 0; row < df.n; row++ {
+		for _, aSeries := range seriess {
+			if aSeries.Value(row) == nil {
+				rows = append(rows, row)
+				break
+			}
+		}
+	}
+
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	if err := df.RemoveRows(rows, DontLock); err != nil {
+		return 0, err
+	}
+	return len(rows), nil
+}
+
 // Update is used to update a specific entry.
 // col can be the name of the series or the column number.
 func (df *DataFrame) Update(row int, col interface{}, val interface{}, options ...Options) {
@@ -311,6 +384,67 @@ func (df *DataFrame) NameToColumn(seriesName string) (int, error) {
 	return 0, errors.New("no series contains name")
 }
 
+// NCols returns the number of series (columns) in the dataframe.
+func (df *DataFrame) NCols(options ...Options) int {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	return len(df.Series)
+}
+
+// ColumnIndex returns the position of the series based on the name.
+// The starting index is 0. The position is consistent with the order
+// of the columns displayed by Table.
+func (df *DataFrame) ColumnIndex(name string, options ...Options) (int, error) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	idx, err := df.NameToColumn(name)
+	if err != nil {
+		return 0, errors.New(err.Error() + ": " + name)
+	}
+	return idx, nil
+}
+
+// SeriesByIndex returns the series at position i.
+// The starting index is 0. The position is consistent with the order
+// of the columns displayed by Table.
+func (df *DataFrame) SeriesByIndex(i int, options ...Options) (Series, error) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	if i < 0 || i >= len(df.Series) {
+		return nil, errors.New("index out of range")
+	}
+	return df.Series[i], nil
+}
+
+// SeriesByName returns the series with the provided name.
+//
+// Example:
+//
+//  s, err := df.SeriesByName("price")
+//  mean := s.(*dataframe.SeriesFloat64).Mean()
+//
+func (df *DataFrame) SeriesByName(name string, options ...Options) (Series, error) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	idx, err := df.NameToColumn(name)
+	if err != nil {
+		return nil, errors.New(err.Error() + ": " + name)
+	}
+	return df.Series[idx], nil
+}
+
 // ReorderColumns reorders the columns based on an ordered list of
 // column names. The length of newOrder must match the number of columns
 // in the dataframe. The column names in newOrder must be unique.
@@ -374,6 +508,28 @@ func (df *DataFrame) Swap(row1, row2 int, options ...Options) {
 	}
 }
 
+// Reverse reverses the order of the rows of every series in place.
+// See utils.Reverse for reversing a subset of rows.
+func (df *DataFrame) Reverse(options ...Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		df.lock.Lock()
+		defer df.lock.Unlock()
+	}
+
+	for idx := range df.Series {
+		if r, ok := df.Series[idx].(interface {
+			Reverse(...Options)
+		}); ok {
+			r.Reverse()
+			continue
+		}
+
+		for i, j := 0, df.n-1; i < j; i, j = i+1, j-1 {
+			df.Series[idx].Swap(i, j)
+		}
+	}
+}
+
 // Lock will lock the dataframe allowing you to directly manipulate
 // the underlying series with confidence.
 func (df *DataFrame) Lock() {
@@ -409,3 +565,43 @@ func (df *DataFrame) Copy(r ...Range) *DataFrame {
 
 	return newDF
 }
+
+// Head returns a copy of the first n rows.
+// If n is larger than the number of rows, all rows are returned.
+func (df *DataFrame) Head(n int, options ...Options) *DataFrame {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	return df.headTail(n, false)
+}
+
+// Tail returns a copy of the last n rows.
+// If n is larger than the number of rows, all rows are returned.
+func (df *DataFrame) Tail(n int, options ...Options) *DataFrame {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	return df.headTail(n, true)
+}
+
+func (df *DataFrame) headTail(n int, tail bool) *DataFrame {
+
+	seriess := []Series{}
+	for i := range df.Series {
+		seriess = append(seriess, headTail(df.Series[i], n, tail))
+	}
+
+	newDF := &DataFrame{
+		Series: seriess,
+	}
+
+	if len(seriess) > 0 {
+		newDF.n = seriess[0].NRows(DontLock)
+	}
+
+	return newDF
+}
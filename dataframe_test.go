@@ -3,11 +3,17 @@
 package dataframe
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"gonum.org/v1/gonum/mat"
 )
 
 func TestNRows(t *testing.T) {
@@ -79,7 +85,7 @@ func TestSwap(t *testing.T) {
 		{56.2, 23.4, 50.3},
 	}
 
-	iterator := df.Values(ValuesOptions{0, 1, true})
+	iterator := df.Values(ValuesOptions{InitialRow: 0, Step: 1, DontReadLock: true})
 	df.Lock()
 	for {
 		row, vals := iterator()
@@ -162,6 +168,7 @@ func TestCopy(t *testing.T) {
 }
 
 func TestSort(t *testing.T) {
+	ctx := context.Background()
 
 	s1 := NewSeriesInt64("day", nil, nil, 1, 2, 4, 3, nil)
 	s2 := NewSeriesFloat64("sales", nil, nil, 50.3, 23.4, 23.4, 56.2, nil)
@@ -172,14 +179,16 @@ func TestSort(t *testing.T) {
 		{Key: "day", SortDesc: false},
 	}
 
-	df.Sort(sks)
+	if err := df.Sort(ctx, sks); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
 
 	expectedValues := [][]interface{}{
 		{int64(3), int64(1), int64(2), int64(4), nil, nil},
 		{56.2, 50.3, 23.4, 23.4, nil, nil},
 	}
 
-	iterator := df.Values(ValuesOptions{0, 1, true})
+	iterator := df.Values(ValuesOptions{InitialRow: 0, Step: 1, DontReadLock: true})
 	df.Lock()
 	for {
 		row, vals := iterator()
@@ -204,3 +213,1207 @@ func TestSort(t *testing.T) {
 	df.Unlock()
 
 }
+
+func TestSortTiebreaker(t *testing.T) {
+	ctx := context.Background()
+
+	// Duplicate primary keys, with the same rows in different input orders
+	dfs := []*DataFrame{
+		NewDataFrame(
+			NewSeriesString("region", nil, "b", "a", "b", "a", nil),
+			NewSeriesInt64("id", nil, 4, 2, 3, 1, 5),
+		),
+		NewDataFrame(
+			NewSeriesString("region", nil, "a", nil, "b", "a", "b"),
+			NewSeriesInt64("id", nil, 1, 5, 3, 2, 4),
+		),
+	}
+
+	sks := []SortKey{
+		{Key: "region"},
+		{Key: "id", SortDesc: true},
+	}
+
+	expectedValues := [][]interface{}{
+		{nil, "a", "a", "b", "b"},
+		{int64(5), int64(2), int64(1), int64(4), int64(3)},
+	}
+
+	for _, df := range dfs {
+		if err := df.Sort(ctx, sks); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		for col := range expectedValues {
+			for row, expected := range expectedValues[col] {
+				actual := df.Series[col].Value(row)
+
+				if !cmp.Equal(expected, actual) {
+					t.Errorf("wrong val: expected: %T %v actual: %T %v", expected, expected, actual, actual)
+				}
+			}
+		}
+	}
+}
+
+func TestProfile(t *testing.T) {
+	ctx := context.Background()
+
+	s1 := NewSeriesInt64("day", nil, 1, 2, 3, nil)
+	s2 := NewSeriesFloat64("sales", nil, 50.0, 20.0, 50.0, 40.0)
+	s3 := NewSeriesString("region", nil, "a", nil, "b", nil)
+	df := NewDataFrame(s1, s2, s3)
+
+	report, err := Profile(ctx, df)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedValues := [][]interface{}{
+		{"day", "sales", "region"},
+		{"int64", "float64", "string"},
+		{int64(3), int64(4), int64(2)},
+		{int64(1), int64(0), int64(2)},
+		{int64(3), int64(3), int64(2)},
+		{2.0, 40.0, nil},
+		{1.0, math.Sqrt(200), nil},
+		{1.0, 20.0, nil},
+		{3.0, 50.0, nil},
+	}
+
+	for col := range expectedValues {
+		for row, expected := range expectedValues[col] {
+			actual := report.Series[col].Value(row)
+
+			if !cmp.Equal(expected, actual) {
+				t.Errorf("wrong val: %s expected: %T %v actual: %T %v", report.Series[col].Name(), expected, expected, actual, actual)
+			}
+		}
+	}
+}
+
+func TestFilterByMask(t *testing.T) {
+	ctx := context.Background()
+
+	s1 := NewSeriesInt64("day", nil, 1, 2, 3, 4)
+	s2 := NewSeriesFloat64("sales", nil, 50.3, nil, 56.2, 12.0)
+	df := NewDataFrame(s1, s2)
+
+	mask, _ := s2.GreaterThan(20)
+
+	filtered, err := FilterByMask(ctx, df, mask)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `+-----+-------+---------+
+|     |  DAY  |  SALES  |
++-----+-------+---------+
+| 0:  |   1   |  50.3   |
+| 1:  |   3   |  56.2   |
++-----+-------+---------+
+| 2X2 | INT64 | FLOAT64 |
++-----+-------+---------+`
+
+	if strings.TrimSpace(filtered.Table()) != strings.TrimSpace(expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, filtered.Table())
+	}
+
+	_, err = FilterByMask(ctx, df, NewSeriesBool("mask", nil, true))
+	if err != ErrMismatchedRows {
+		t.Errorf("expected error: %v actual: %v", ErrMismatchedRows, err)
+	}
+}
+
+func TestApplyMulti(t *testing.T) {
+	ctx := context.Background()
+
+	s1 := NewSeriesFloat64("price", nil, 10.0, 50.0, nil)
+	s2 := NewSeriesInt64("qty", nil, 3, 4, 1)
+	df := NewDataFrame(s1, s2)
+
+	outputs := []ColumnSpec{{"total", float64(0)}, {"bulk", false}}
+
+	fn := func(vals map[string]interface{}) (map[string]interface{}, error) {
+		if vals["price"] == nil {
+			return nil, nil
+		}
+		total := vals["price"].(float64) * float64(vals["qty"].(int64))
+		return map[string]interface{}{"total": total, "bulk": total > 100}, nil
+	}
+
+	newDF, err := ApplyMulti(ctx, df, outputs, fn)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []Series{
+		NewSeriesFloat64("price", nil, 10.0, 50.0, nil),
+		NewSeriesInt64("qty", nil, 3, 4, 1),
+		NewSeriesFloat64("total", nil, 30.0, 200.0, nil),
+		NewSeriesBool("bulk", nil, false, true, nil),
+	}
+
+	if newDF.NRows() != 3 || len(newDF.Series) != len(expected) {
+		t.Fatalf("wrong val: expected: %v actual: %v", NewDataFrame(expected...), newDF)
+	}
+
+	for i := range expected {
+		actual := newDF.Series[i]
+		if actual.Name() != expected[i].Name() || actual.Type() != expected[i].Type() || fmt.Sprint(actual) != fmt.Sprint(expected[i]) {
+			t.Errorf("wrong val: expected: %v actual: %v", expected[i], actual)
+		}
+	}
+
+	if len(df.Series) != 2 {
+		t.Errorf("wrong val: expected: %v actual: %v", 2, len(df.Series))
+	}
+
+	// Unknown output
+	_, err = ApplyMulti(ctx, df, outputs, func(vals map[string]interface{}) (map[string]interface{}, error) {
+		return map[string]interface{}{"qty": int64(1)}, nil
+	})
+	if err == nil {
+		t.Errorf("wrong val: expected: %v actual: %v", "error", err)
+	}
+}
+
+func TestCastColumn(t *testing.T) {
+
+	s1 := NewSeriesString("price", nil, "1.5", "N/A", nil, "3")
+	df := NewDataFrame(s1)
+
+	// Default: abort on unconvertible value
+	err := df.CastColumn("price", "float64", nil)
+	if err == nil {
+		t.Errorf("wrong val: expected: %v actual: %v", "error", err)
+	}
+	if df.Series[0].Type() != "string" {
+		t.Errorf("wrong val: expected: %v actual: %v", "string", df.Series[0].Type())
+	}
+
+	var badRows []int
+	err = df.CastColumn("price", "float64", func(row int, raw interface{}) (interface{}, error) {
+		badRows = append(badRows, row)
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := NewSeriesFloat64("price", nil, 1.5, nil, nil, 3.0)
+	if !cmp.Equal(df.Series[0], expected, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, df.Series[0])
+	}
+
+	if !cmp.Equal(badRows, []int{1}) {
+		t.Errorf("wrong val: expected: %v actual: %v", []int{1}, badRows)
+	}
+
+	// Callback aborts
+	errAbort := fmt.Errorf("abort")
+	err = df.CastColumn("price", "int64", func(row int, raw interface{}) (interface{}, error) {
+		return nil, errAbort
+	})
+	if err != errAbort {
+		t.Errorf("wrong val: expected: %v actual: %v", errAbort, err)
+	}
+}
+
+func TestColumnIndex(t *testing.T) {
+
+	s1 := NewSeriesInt64("day", nil, 1, 2, 3)
+	s2 := NewSeriesFloat64("sales", nil, 50.3, 23.4, 56.2)
+	df := NewDataFrame(s1, s2)
+
+	if df.NCols() != 2 {
+		t.Errorf("wrong val: expected: %v actual: %v", 2, df.NCols())
+	}
+
+	for i, name := range df.Names() {
+		idx, err := df.ColumnIndex(name)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if idx != i {
+			t.Errorf("wrong val: expected: %v actual: %v", i, idx)
+		}
+
+		s, err := df.SeriesByIndex(idx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if s.Name() != name {
+			t.Errorf("wrong val: expected: %v actual: %v", name, s.Name())
+		}
+	}
+
+	if _, err := df.ColumnIndex("unknown"); err == nil {
+		t.Errorf("wrong val: expected: %v actual: %v", "error", err)
+	}
+
+	if _, err := df.SeriesByIndex(2); err == nil {
+		t.Errorf("wrong val: expected: %v actual: %v", "error", err)
+	}
+
+	sales, err := df.SeriesByName("sales")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sales != s2 {
+		t.Errorf("wrong val: expected: %v actual: %v", s2, sales)
+	}
+
+	if _, err := df.SeriesByName("unknown"); err == nil {
+		t.Errorf("wrong val: expected: %v actual: %v", "error", err)
+	}
+}
+
+func TestBinary(t *testing.T) {
+	ctx := context.Background()
+
+	tm := time.Date(2019, time.May, 1, 10, 30, 0, 0, time.UTC)
+
+	df := NewDataFrame(
+		NewSeriesInt64("day", nil, 1, 2, nil),
+		NewSeriesFloat64("sales", nil, 50.3, nil, 56.2),
+		NewSeriesString("region", nil, "north", "south", nil),
+		NewSeriesTime("date", nil, tm, nil, tm.Add(time.Hour)),
+		NewSeriesBool("open", nil, true, false, nil),
+	)
+
+	for _, compress := range []bool{false, true} {
+		var buf bytes.Buffer
+		err := WriteBinary(ctx, &buf, df, BinaryOptions{Compress: compress})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		newDF, err := ReadBinary(ctx, &buf)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if newDF.Table() != df.Table() {
+			t.Errorf("wrong val: expected: %v actual: %v", df.Table(), newDF.Table())
+		}
+
+		for i := range df.Series {
+			v := newDF.Series[i].(interface {
+				Validate(...Options) error
+			})
+			if err := v.Validate(); err != nil {
+				t.Errorf("wrong val: expected: %v actual: %v", nil, err)
+			}
+		}
+	}
+
+	// Unknown version
+	var buf bytes.Buffer
+	WriteBinary(ctx, &buf, df)
+	data := buf.Bytes()
+	data[4] = BinaryVersion + 1
+
+	_, err := ReadBinary(ctx, bytes.NewReader(data))
+	if err == nil || !strings.Contains(err.Error(), "unsupported binary format version") {
+		t.Errorf("wrong val: expected: %v actual: %v", "unsupported binary format version", err)
+	}
+
+	// Not binary format
+	_, err = ReadBinary(ctx, strings.NewReader("day,sales\n1,50.3\n"))
+	if err == nil {
+		t.Errorf("wrong val: expected: %v actual: %v", "error", err)
+	}
+}
+
+func TestBinarySize(t *testing.T) {
+	ctx := context.Background()
+
+	s1 := NewSeriesInt64("id", &SeriesInit{Capacity: 10000})
+	s2 := NewSeriesFloat64("val", &SeriesInit{Capacity: 10000})
+	for i := 0; i < 10000; i++ {
+		s1.Append(int64(i))
+		s2.Append(float64(i%100) / 4)
+	}
+	df := NewDataFrame(s1, s2)
+
+	var csvBuf bytes.Buffer
+	csvBuf.WriteString("id,val\n")
+	for i := 0; i < 10000; i++ {
+		csvBuf.WriteString(df.Series[0].ValueString(i) + "," + df.Series[1].ValueString(i) + "\n")
+	}
+
+	var binBuf bytes.Buffer
+	if err := WriteBinary(ctx, &binBuf, df, BinaryOptions{Compress: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if binBuf.Len() >= csvBuf.Len() {
+		t.Errorf("compressed binary (%d bytes) is not smaller than csv (%d bytes)", binBuf.Len(), csvBuf.Len())
+	}
+}
+
+func TestJoin(t *testing.T) {
+	ctx := context.Background()
+
+	left := NewDataFrame(
+		NewSeriesInt64("id", nil, 1, 2, 3, nil),
+		NewSeriesString("name", nil, "a", "b", "c", "d"),
+	)
+
+	right := NewDataFrame(
+		NewSeriesInt64("id", nil, 3, 1, 4),
+		NewSeriesFloat64("score", nil, 30.0, 10.0, 40.0),
+	)
+
+	tests := []struct {
+		joinType JoinType
+		expected *DataFrame
+	}{
+		{
+			InnerJoin,
+			NewDataFrame(
+				NewSeriesInt64("id", nil, 1, 3),
+				NewSeriesString("name", nil, "a", "c"),
+				NewSeriesFloat64("score", nil, 10.0, 30.0),
+			),
+		},
+		{
+			LeftJoin,
+			NewDataFrame(
+				NewSeriesInt64("id", nil, 1, 2, 3, nil),
+				NewSeriesString("name", nil, "a", "b", "c", "d"),
+				NewSeriesFloat64("score", nil, 10.0, nil, 30.0, nil),
+			),
+		},
+		{
+			RightJoin,
+			NewDataFrame(
+				NewSeriesInt64("id", nil, 1, 3, 4),
+				NewSeriesString("name", nil, "a", "c", nil),
+				NewSeriesFloat64("score", nil, 10.0, 30.0, 40.0),
+			),
+		},
+		{
+			OuterJoin,
+			NewDataFrame(
+				NewSeriesInt64("id", nil, 1, 2, 3, nil, 4),
+				NewSeriesString("name", nil, "a", "b", "c", "d", nil),
+				NewSeriesFloat64("score", nil, 10.0, nil, 30.0, nil, 40.0),
+			),
+		},
+	}
+
+	for i, tc := range tests {
+		joined, err := Join(ctx, left, right, []string{"id"}, JoinOptions{JoinType: tc.joinType})
+		if err != nil {
+			t.Fatalf("%d: unexpected error: %v", i, err)
+		}
+
+		if joined.Table() != tc.expected.Table() {
+			t.Errorf("%d: wrong val: expected: %v actual: %v", i, tc.expected.Table(), joined.Table())
+		}
+	}
+}
+
+func TestJoinFloatTolerance(t *testing.T) {
+	ctx := context.Background()
+
+	a, b := 0.1, 0.2
+
+	left := NewDataFrame(
+		NewSeriesFloat64("x", nil, a+b, 1.0, 2.5),
+		NewSeriesString("name", nil, "a", "b", "c"),
+	)
+
+	right := NewDataFrame(
+		NewSeriesFloat64("x", nil, 0.3, 1.00000000001, 2.6),
+		NewSeriesInt64("val", nil, 1, 2, 3),
+	)
+
+	// Exact equality drops rows that should match
+	joined, err := Join(ctx, left, right, []string{"x"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if joined.NRows() != 0 {
+		t.Errorf("wrong val: expected: %v actual: %v", 0, joined.NRows())
+	}
+
+	joined, err = Join(ctx, left, right, []string{"x"}, JoinOptions{FloatTolerance: 1e-9})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := NewDataFrame(
+		NewSeriesFloat64("x", nil, a+b, 1.0),
+		NewSeriesString("name", nil, "a", "b"),
+		NewSeriesInt64("val", nil, 1, 2),
+	)
+
+	if joined.Table() != expected.Table() {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), joined.Table())
+	}
+
+	// Keys on either side of zero
+	left = NewDataFrame(NewSeriesFloat64("x", nil, -0.05), NewSeriesString("name", nil, "a"))
+	right = NewDataFrame(NewSeriesFloat64("x", nil, 0.05, -0.05), NewSeriesInt64("val", nil, 1, 2))
+
+	joined, err = Join(ctx, left, right, []string{"x"}, JoinOptions{FloatTolerance: 0.1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected = NewDataFrame(
+		NewSeriesFloat64("x", nil, -0.05, -0.05),
+		NewSeriesString("name", nil, "a", "a"),
+		NewSeriesInt64("val", nil, 1, 2),
+	)
+
+	if joined.Table() != expected.Table() {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), joined.Table())
+	}
+}
+
+func TestExplode(t *testing.T) {
+	ctx := context.Background()
+
+	df := NewDataFrame(
+		NewSeriesInt64("id", nil, 1, 2, 3, 4),
+		NewSeriesGeneric("tags", []string(nil), nil, []string{"a", "b"}, []string{}, nil, []string{"c"}),
+	)
+
+	exploded, err := Explode(ctx, df, "tags")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := NewDataFrame(
+		NewSeriesInt64("id", nil, 1, 1, 4),
+		NewSeriesString("tags", nil, "a", "b", "c"),
+	)
+
+	if exploded.Table() != expected.Table() {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), exploded.Table())
+	}
+
+	exploded, err = Explode(ctx, df, "tags", ExplodeOptions{KeepEmpty: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected = NewDataFrame(
+		NewSeriesInt64("id", nil, 1, 1, 2, 3, 4),
+		NewSeriesString("tags", nil, "a", "b", nil, nil, "c"),
+	)
+
+	if exploded.Table() != expected.Table() {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), exploded.Table())
+	}
+
+	if _, err := Explode(ctx, df, "id"); err == nil {
+		t.Errorf("wrong val: expected: %v actual: %v", "error", err)
+	}
+}
+
+func TestRemoveRows(t *testing.T) {
+
+	df := NewDataFrame(
+		NewSeriesInt64("day", nil, 1, 2, nil, 4, 5),
+		NewSeriesFloat64("sales", nil, 50.3, nil, 56.2, 12.0, 1.0),
+		NewSeriesString("region", nil, "a", "b", "c", nil, "e"),
+	)
+
+	// Duplicate rows
+	if err := df.RemoveRows([]int{1, 1}); err == nil {
+		t.Errorf("wrong val: expected: %v actual: %v", "error", err)
+	}
+
+	// Out of range
+	if err := df.RemoveRows([]int{0, 5}); err == nil {
+		t.Errorf("wrong val: expected: %v actual: %v", "error", err)
+	}
+
+	if df.NRows() != 5 {
+		t.Errorf("wrong val: expected: %v actual: %v", 5, df.NRows())
+	}
+
+	if err := df.RemoveRows([]int{3, 0, 2}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := NewDataFrame(
+		NewSeriesInt64("day", nil, 2, 5),
+		NewSeriesFloat64("sales", nil, nil, 1.0),
+		NewSeriesString("region", nil, "b", "e"),
+	)
+
+	if df.Table() != expected.Table() {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), df.Table())
+	}
+
+	for i := range df.Series {
+		v := df.Series[i].(interface {
+			Validate(...Options) error
+		})
+		if err := v.Validate(); err != nil {
+			t.Errorf("wrong val: expected: %v actual: %v", nil, err)
+		}
+	}
+}
+
+func TestMelt(t *testing.T) {
+	ctx := context.Background()
+
+	df := NewDataFrame(
+		NewSeriesString("id", nil, "a", "b"),
+		NewSeriesInt64("q1", nil, 1, nil),
+		NewSeriesInt64("q2", nil, 3, 4),
+		NewSeriesFloat64("q3", nil, 5.5, 6.5),
+	)
+
+	tests := []struct {
+		valueVars []string
+		expected  *DataFrame
+	}{
+		{
+			[]string{"q1", "q2"},
+			NewDataFrame(
+				NewSeriesString("id", nil, "a", "b", "a", "b"),
+				NewSeriesString("variable", nil, "q1", "q1", "q2", "q2"),
+				NewSeriesInt64("value", nil, 1, nil, 3, 4),
+			),
+		},
+		{
+			nil,
+			NewDataFrame(
+				NewSeriesString("id", nil, "a", "b", "a", "b", "a", "b"),
+				NewSeriesString("variable", nil, "q1", "q1", "q2", "q2", "q3", "q3"),
+				NewSeriesFloat64("value", nil, 1.0, nil, 3.0, 4.0, 5.5, 6.5),
+			),
+		},
+		{
+			[]string{"id", "q2"},
+			NewDataFrame(
+				NewSeriesString("id", nil, "a", "b", "a", "b"),
+				NewSeriesString("variable", nil, "id", "id", "q2", "q2"),
+				NewSeriesString("value", nil, "a", "b", "3", "4"),
+			),
+		},
+	}
+
+	for i, tc := range tests {
+		melted, err := Melt(ctx, df, []string{"id"}, tc.valueVars)
+		if err != nil {
+			t.Fatalf("%d: unexpected error: %v", i, err)
+		}
+
+		if melted.Table() != tc.expected.Table() {
+			t.Errorf("%d: wrong val: expected: %v actual: %v", i, tc.expected.Table(), melted.Table())
+		}
+	}
+
+	_, err := Melt(ctx, df, []string{"id"}, nil, MeltOptions{VarName: "value"})
+	if err == nil {
+		t.Errorf("expected error when VarName and ValueName are the same")
+	}
+}
+
+func TestFilter(t *testing.T) {
+	ctx := context.Background()
+
+	s1 := NewSeriesInt64("day", nil, 1, 2, 3, 4)
+	s2 := NewSeriesFloat64("sales", nil, 50.3, nil, 56.2, 12.0)
+	df := NewDataFrame(s1, s2)
+
+	filtered, err := Filter(ctx, df, func(vals map[interface{}]interface{}, row, nRows int) (FilterAction, error) {
+		if vals["sales"] == nil || vals[0].(int64) == 3 {
+			return Drop, nil
+		}
+		return Keep, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := NewDataFrame(
+		NewSeriesInt64("day", nil, 1, 4),
+		NewSeriesFloat64("sales", nil, 50.3, 12.0),
+	)
+
+	if filtered.Table() != expected.Table() {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), filtered.Table())
+	}
+
+	_, err = Filter(ctx, df, func(vals map[interface{}]interface{}, row, nRows int) (FilterAction, error) {
+		return Keep, fmt.Errorf("row %d of %d", row, nRows)
+	})
+	if err == nil || err.Error() != "row 0 of 4" {
+		t.Errorf("wrong val: expected: %v actual: %v", "row 0 of 4", err)
+	}
+}
+
+func TestSortCancelled(t *testing.T) {
+
+	df := NewDataFrame(
+		NewSeriesInt64("day", nil, 3, 1, 2),
+		NewSeriesFloat64("sales", nil, 56.2, 50.3, 23.4),
+	)
+	expected := df.Table()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := df.Sort(ctx, []SortKey{{Key: "day"}})
+	if err != context.Canceled {
+		t.Errorf("wrong val: expected: %v actual: %v", context.Canceled, err)
+	}
+
+	if df.Table() != expected {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, df.Table())
+	}
+
+	if err := df.Sort(context.Background(), []SortKey{{Key: "unknown"}}); err == nil {
+		t.Errorf("wrong val: expected: %v actual: %v", "error", err)
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	ctx := context.Background()
+
+	df := NewDataFrame(
+		NewSeriesString("region", nil, "b", "a", "b", nil, "a", "b"),
+		NewSeriesInt64("qty", nil, 1, 2, 3, 4, nil, 6),
+		NewSeriesFloat64("price", nil, 10.0, 20.0, nil, 40.0, 50.0, 30.0),
+	)
+
+	groups, err := GroupBy(ctx, df, []string{"region"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if groups.NGroups() != 3 {
+		t.Errorf("wrong val: expected: %v actual: %v", 3, groups.NGroups())
+	}
+
+	summary, err := groups.Aggregate(ctx, map[string]AggFn{
+		"qty":   AggSum,
+		"price": AggMean,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := NewDataFrame(
+		NewSeriesString("region", nil, "b", "a", nil),
+		NewSeriesFloat64("price", nil, 20.0, 35.0, 40.0),
+		NewSeriesInt64("qty", nil, 10, 2, 4),
+	)
+
+	if summary.Table() != expected.Table() {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), summary.Table())
+	}
+
+	summary, err = groups.Aggregate(ctx, map[string]AggFn{
+		"qty":   AggCount,
+		"price": AggMax,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected = NewDataFrame(
+		NewSeriesString("region", nil, "b", "a", nil),
+		NewSeriesFloat64("price", nil, 30.0, 50.0, 40.0),
+		NewSeriesInt64("qty", nil, 3, 1, 1),
+	)
+
+	if summary.Table() != expected.Table() {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), summary.Table())
+	}
+
+	if _, err := groups.Aggregate(ctx, map[string]AggFn{"region": AggSum}); err == nil {
+		t.Errorf("wrong val: expected: %v actual: %v", "error", err)
+	}
+}
+
+func TestGroupByHashCollision(t *testing.T) {
+	ctx := context.Background()
+
+	// []string{"a b"} and []string{"a", "b"} have the same %v representation
+	df := NewDataFrame(
+		NewSeriesGeneric("tags", []string(nil), nil, []string{"a b"}, []string{"a", "b"}, []string{"a b"}, nil),
+		NewSeriesInt64("qty", nil, 1, 2, 3, 4),
+	)
+
+	groups, err := GroupBy(ctx, df, []string{"tags"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if groups.NGroups() != 3 {
+		t.Errorf("wrong val: expected: %v actual: %v", 3, groups.NGroups())
+	}
+
+	summary, err := groups.Aggregate(ctx, map[string]AggFn{"qty": AggSum})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := NewSeriesInt64("qty", nil, 4, 2, 4)
+	if actual := summary.Series[1]; fmt.Sprint(actual) != fmt.Sprint(expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual)
+	}
+}
+
+func TestJoinSuffixes(t *testing.T) {
+	ctx := context.Background()
+
+	left := NewDataFrame(
+		NewSeriesInt64("id", nil, 1, 2),
+		NewSeriesFloat64("score", nil, 1.0, 2.0),
+	)
+
+	right := NewDataFrame(
+		NewSeriesInt64("id", nil, 2, 1),
+		NewSeriesFloat64("score", nil, 20.0, 10.0),
+	)
+
+	joined, err := Join(ctx, left, right, []string{"id"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := NewDataFrame(
+		NewSeriesInt64("id", nil, 1, 2),
+		NewSeriesFloat64("score_left", nil, 1.0, 2.0),
+		NewSeriesFloat64("score_right", nil, 10.0, 20.0),
+	)
+
+	if joined.Table() != expected.Table() {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), joined.Table())
+	}
+
+	joined, err = Join(ctx, left, right, []string{"id"}, JoinOptions{LeftSuffix: "_x", RightSuffix: "_y"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !cmp.Equal([]string{"id", "score_x", "score_y"}, joined.Names()) {
+		t.Errorf("wrong val: expected: %v actual: %v", []string{"id", "score_x", "score_y"}, joined.Names())
+	}
+
+	// Original series must not be renamed
+	if left.Series[1].Name() != "score" || right.Series[1].Name() != "score" {
+		t.Errorf("wrong val: expected: %v actual: %v", "score", left.Series[1].Name())
+	}
+}
+
+func TestConcat(t *testing.T) {
+	ctx := context.Background()
+
+	df1 := NewDataFrame(
+		NewSeriesInt64("day", nil, 1, 2),
+		NewSeriesFloat64("sales", nil, 50.3, nil),
+	)
+
+	df2 := NewDataFrame(
+		NewSeriesFloat64("sales", nil, 23.4),
+		NewSeriesInt64("day", nil, 3),
+	)
+
+	df3 := NewDataFrame(
+		NewSeriesInt64("day", nil, 4),
+		NewSeriesString("note", nil, "x"),
+	)
+
+	concatenated, err := Concat(ctx, []*DataFrame{df1, df2}, VerticalAxis)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := NewDataFrame(
+		NewSeriesInt64("day", nil, 1, 2, 3),
+		NewSeriesFloat64("sales", nil, 50.3, nil, 23.4),
+	)
+
+	if concatenated.Table() != expected.Table() {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), concatenated.Table())
+	}
+
+	if _, err := Concat(ctx, []*DataFrame{df1, df3}, VerticalAxis); err == nil {
+		t.Errorf("wrong val: expected: %v actual: %v", "error", err)
+	}
+
+	concatenated, err = Concat(ctx, []*DataFrame{df1, df3}, VerticalAxis, ConcatOptions{FillMissing: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected = NewDataFrame(
+		NewSeriesInt64("day", nil, 1, 2, 4),
+		NewSeriesFloat64("sales", nil, 50.3, nil, nil),
+		NewSeriesString("note", nil, nil, nil, "x"),
+	)
+
+	if concatenated.Table() != expected.Table() {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), concatenated.Table())
+	}
+
+	mismatched := NewDataFrame(NewSeriesString("day", nil, "5"))
+	if _, err := Concat(ctx, []*DataFrame{df1, mismatched}, VerticalAxis, ConcatOptions{FillMissing: true}); err == nil {
+		t.Errorf("wrong val: expected: %v actual: %v", "error", err)
+	}
+
+	concatenated, err = Concat(ctx, []*DataFrame{df1, NewDataFrame(NewSeriesString("note", nil, "a", "b"))}, HorizontalAxis)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected = NewDataFrame(
+		NewSeriesInt64("day", nil, 1, 2),
+		NewSeriesFloat64("sales", nil, 50.3, nil),
+		NewSeriesString("note", nil, "a", "b"),
+	)
+
+	if concatenated.Table() != expected.Table() {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), concatenated.Table())
+	}
+
+	if _, err := Concat(ctx, []*DataFrame{df1, df3}, HorizontalAxis); err != ErrMismatchedRows {
+		t.Errorf("wrong val: expected: %v actual: %v", ErrMismatchedRows, err)
+	}
+}
+
+func TestMatrix(t *testing.T) {
+
+	df := NewDataFrame(
+		NewSeriesFloat64("x", nil, 1, 2, 3),
+		NewSeriesFloat64("y", nil, 4, nil, 6),
+		NewSeriesInt64("z", nil, 7, 8, 9),
+	)
+
+	m, err := df.ToMatrix([]string{"x"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := mat.NewDense(3, 1, []float64{1, 2, 3})
+	if !mat.Equal(m, expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, m)
+	}
+
+	if _, err := df.ToMatrix([]string{"x", "y"}); err == nil {
+		t.Errorf("expected error for NaN values")
+	}
+
+	if _, err := df.ToMatrix([]string{"z"}); err == nil {
+		t.Errorf("expected error for non-float64 series")
+	}
+
+	m, err = df.ToMatrix([]string{"y", "x"}, MatrixOptions{AllowNaN: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actual := NewDataFrameFromMatrix(m, "y", "x")
+	expectedDf := NewDataFrame(
+		NewSeriesFloat64("y", nil, 4, nil, 6),
+		NewSeriesFloat64("x", nil, 1, 2, 3),
+	)
+
+	if actual.Table() != expectedDf.Table() {
+		t.Errorf("wrong val: expected: %v actual: %v", expectedDf.Table(), actual.Table())
+	}
+
+	if nc := actual.Series[0].(*SeriesFloat64).nilCount; nc != 1 {
+		t.Errorf("wrong val: expected: %v actual: %v", 1, nc)
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	ctx := context.Background()
+
+	df := NewDataFrame(
+		NewSeriesFloat64("x", nil, 1, 2, nil, 3, 4),
+		NewSeriesString("name", nil, "a", "b", "c", "d", "e"),
+		NewSeriesInt64("n", nil, nil, nil, nil, nil, 10),
+	)
+
+	actual, err := Describe(ctx, df)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := NewDataFrame(
+		NewSeriesString("statistic", nil, "count", "mean", "std", "min", "25%", "50%", "75%", "max"),
+		NewSeriesFloat64("x", nil, 4, 2.5, 1.2909944487358056, 1, 1.75, 2.5, 3.25, 4),
+		NewSeriesFloat64("n", nil, 1, 10, nil, 10, 10, 10, 10, 10),
+	)
+
+	if actual.Table() != expected.Table() {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), actual.Table())
+	}
+
+	// Numeric series name conflicts with the label series
+	conflict := NewDataFrame(NewSeriesFloat64("statistic", nil, 1.0, 2.0))
+	if _, err := Describe(ctx, conflict); err == nil {
+		t.Errorf("expected error for series named statistic")
+	}
+}
+
+func TestDropNil(t *testing.T) {
+
+	newDf := func() *DataFrame {
+		return NewDataFrame(
+			NewSeriesInt64("id", nil, 1, 2, 3, 4),
+			NewSeriesFloat64("x", nil, 1, nil, 3, 4),
+			NewSeriesString("s", nil, "a", "b", nil, "d"),
+		)
+	}
+
+	df := newDf()
+	removed, err := df.DropNil(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := NewDataFrame(
+		NewSeriesInt64("id", nil, 1, 4),
+		NewSeriesFloat64("x", nil, 1, 4),
+		NewSeriesString("s", nil, "a", "d"),
+	)
+
+	if removed != 2 || df.Table() != expected.Table() {
+		t.Errorf("wrong val: expected: %v actual: %v (%d removed)", expected.Table(), df.Table(), removed)
+	}
+
+	df = newDf()
+	if _, err := df.DropNil([]string{"x"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected = NewDataFrame(
+		NewSeriesInt64("id", nil, 1, 3, 4),
+		NewSeriesFloat64("x", nil, 1, 3, 4),
+		NewSeriesString("s", nil, "a", nil, "d"),
+	)
+
+	if df.Table() != expected.Table() {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), df.Table())
+	}
+
+	if _, err := df.DropNil([]string{"unknown"}); err == nil {
+		t.Errorf("expected error for unknown series")
+	}
+}
+
+func TestValuesSkipNil(t *testing.T) {
+
+	df := NewDataFrame(
+		NewSeriesInt64("id", nil, 1, 2, 3),
+		NewSeriesFloat64("x", nil, 1.5, nil, 3.5),
+	)
+
+	rows := []int{}
+
+	iterator := df.Values(ValuesOptions{SkipNil: true})
+	for {
+		row, vals := iterator()
+		if row == nil {
+			break
+		}
+		if vals["id"] != vals[0] {
+			t.Errorf("wrong val: expected: %v actual: %v", vals["id"], vals[0])
+		}
+		rows = append(rows, *row)
+	}
+
+	if !cmp.Equal(rows, []int{0, 2}) {
+		t.Errorf("wrong val: expected: %v actual: %v", []int{0, 2}, rows)
+	}
+}
+
+func TestReverse(t *testing.T) {
+
+	df := NewDataFrame(
+		NewSeriesInt64("id", nil, 1, 2, 3),
+		NewSeriesString("s", nil, "a", nil, "c"),
+	)
+	df.Reverse()
+
+	expected := NewDataFrame(
+		NewSeriesInt64("id", nil, 3, 2, 1),
+		NewSeriesString("s", nil, "c", nil, "a"),
+	)
+
+	if df.Table() != expected.Table() {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), df.Table())
+	}
+}
+
+func TestHeadTail(t *testing.T) {
+
+	df := NewDataFrame(
+		NewSeriesInt64("id", nil, 1, 2, 3),
+		NewSeriesString("s", nil, "a", nil, "c"),
+	)
+
+	expected := NewDataFrame(
+		NewSeriesInt64("id", nil, 1, 2),
+		NewSeriesString("s", nil, "a", nil),
+	)
+	if actual := df.Head(2); actual.Table() != expected.Table() {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), actual.Table())
+	}
+
+	expected = NewDataFrame(
+		NewSeriesInt64("id", nil, 3),
+		NewSeriesString("s", nil, "c"),
+	)
+	if actual := df.Tail(1); actual.Table() != expected.Table() {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), actual.Table())
+	}
+
+	if actual := df.Tail(5); actual.NRows() != 3 {
+		t.Errorf("wrong val: expected: %v actual: %v", 3, actual.NRows())
+	}
+}
+
+func TestSample(t *testing.T) {
+	ctx := context.Background()
+
+	df := NewDataFrame(
+		NewSeriesInt64("id", nil, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9),
+		NewSeriesInt64("double", nil, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18),
+	)
+
+	sample, err := Sample(ctx, df, 5, 42)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if sample.NRows() != 5 {
+		t.Errorf("wrong val: expected: %v actual: %v", 5, sample.NRows())
+	}
+
+	seen := map[int64]bool{}
+	for row := 0; row < sample.NRows(); row++ {
+		id := sample.Series[0].Value(row).(int64)
+		if seen[id] {
+			t.Errorf("row %d selected more than once", id)
+		}
+		seen[id] = true
+
+		if double := sample.Series[1].Value(row).(int64); double != 2*id {
+			t.Errorf("wrong val: expected: %v actual: %v", 2*id, double)
+		}
+	}
+
+	// Reproducible
+	again, _ := Sample(ctx, df, 5, 42)
+	if sample.Table() != again.Table() {
+		t.Errorf("wrong val: expected: %v actual: %v", sample.Table(), again.Table())
+	}
+
+	if _, err := Sample(ctx, df, 11, 42); err == nil {
+		t.Errorf("expected error when n is greater than the number of rows")
+	}
+
+	replaced, err := Sample(ctx, df, 20, 42, SampleOptions{Replace: true})
+	if err != nil || replaced.NRows() != 20 {
+		t.Errorf("wrong val: expected: %v actual: %v (%v)", 20, replaced.NRows(), err)
+	}
+
+	s, err := SampleSeries(ctx, df.Series[0], 3, 7)
+	if err != nil || s.NRows() != 3 {
+		t.Errorf("wrong val: expected: %v actual: %v (%v)", 3, s.NRows(), err)
+	}
+}
+
+func TestSplit(t *testing.T) {
+	ctx := context.Background()
+
+	df := NewDataFrame(
+		NewSeriesInt64("id", nil, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9),
+		NewSeriesString("s", nil, "0", "1", "2", "3", "4", "5", "6", "7", "8", "9"),
+	)
+
+	train, test, err := Split(ctx, df, 0.7, 42)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if train.NRows() != 7 || test.NRows() != 3 {
+		t.Errorf("wrong val: expected: %v %v actual: %v %v", 7, 3, train.NRows(), test.NRows())
+	}
+
+	seen := map[int64]bool{}
+	for _, part := range []*DataFrame{train, test} {
+		for row := 0; row < part.NRows(); row++ {
+			id := part.Series[0].Value(row).(int64)
+			if seen[id] {
+				t.Errorf("row %d in both partitions", id)
+			}
+			seen[id] = true
+
+			if s := part.Series[1].Value(row).(string); s != fmt.Sprint(id) {
+				t.Errorf("wrong val: expected: %v actual: %v", id, s)
+			}
+		}
+	}
+
+	if len(seen) != 10 {
+		t.Errorf("wrong val: expected: %v actual: %v", 10, len(seen))
+	}
+
+	if _, _, err := Split(ctx, df, 1.5, 42); err == nil {
+		t.Errorf("expected error for fraction out of range")
+	}
+}
+
+func TestIsEqual(t *testing.T) {
+
+	df1 := NewDataFrame(
+		NewSeriesInt64("a", nil, 1, nil, 3),
+		NewSeriesFloat64("b", nil, 1.5, 2.5, nil),
+		NewSeriesGeneric("c", 0.0, nil, 1.0, math.NaN(), nil),
+	)
+
+	df2 := NewDataFrame(
+		NewSeriesInt64("a", nil, 1, nil, 3),
+		NewSeriesFloat64("b", nil, 1.5, 2.5, nil),
+		NewSeriesGeneric("c", 0.0, nil, 1.0, math.NaN(), nil),
+	)
+
+	tests := []struct {
+		other    *DataFrame
+		opts     []IsEqualOptions
+		expected bool
+	}{
+		{df1, nil, true},
+		{df2, nil, false}, // NaN != NaN in SeriesGeneric
+		{df2, []IsEqualOptions{{NaNEqual: true}}, true},
+		{NewDataFrame(df2.Series[2], df2.Series[1], df2.Series[0]), []IsEqualOptions{{NaNEqual: true}}, false},
+		{NewDataFrame(df2.Series[2], df2.Series[1], df2.Series[0]), []IsEqualOptions{{IgnoreOrder: true, NaNEqual: true}}, true},
+		{NewDataFrame(NewSeriesInt64("a", nil, 1, nil, 3), NewSeriesFloat64("b", nil, 1.5, 2.5, 0.0), df2.Series[2]), []IsEqualOptions{{NaNEqual: true}}, false},
+		{NewDataFrame(NewSeriesFloat64("a", nil, 1, nil, 3), df2.Series[1], df2.Series[2]), []IsEqualOptions{{NaNEqual: true}}, false},
+		{NewDataFrame(df2.Series[0], df2.Series[1]), nil, false},
+	}
+
+	for i, tc := range tests {
+		eq, err := df1.IsEqual(tc.other, tc.opts...)
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+		}
+		if eq != tc.expected {
+			t.Errorf("%d: wrong val: expected: %v actual: %v", i, tc.expected, eq)
+		}
+	}
+
+	dup := NewDataFrame(NewSeriesInt64("a", nil, 1), NewSeriesInt64("b", nil, 1))
+	dup.Series[1].Rename("a")
+	if _, err := dup.IsEqual(dup.Copy(), IsEqualOptions{IgnoreOrder: true}); err == nil {
+		t.Errorf("expected error for duplicate series names")
+	}
+}
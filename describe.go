@@ -0,0 +1,79 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+// DescribeOptions is used to modify the behaviour of Describe().
+type DescribeOptions struct {
+	// Don't apply read lock to the dataframe.
+	DontLock bool
+}
+
+// Describe generates summary statistics for the numeric series (float64 and int64) of df.
+// The first series of the returned dataframe labels each statistic: count, mean, std, min,
+// 25%, 50%, 75% and max. Each numeric series of df is summarized in a float64 series of the same name.
+// Nil values are ignored. Non-numeric series are skipped.
+// An error is returned if a numeric series is named "statistic".
+//
+// Example:
+//
+//  summary, _ := dataframe.Describe(ctx, df)
+//  fmt.Print(summary.Table())
+//
+func Describe(ctx context.Context, df *DataFrame, options ...DescribeOptions) (*DataFrame, error) {
+
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	stats := NewSeriesString("statistic", nil, "count", "mean", "std", "min", "25%", "50%", "75%", "max")
+	seriess := []Series{stats}
+
+	for _, aSeries := range df.Series {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		var fs *SeriesFloat64
+		switch s := aSeries.(type) {
+		case *SeriesFloat64:
+			fs = s
+		case *SeriesInt64:
+			fs = s.ToSeriesFloat64()
+		default:
+			continue
+		}
+
+		if aSeries.Name() == stats.Name() {
+			return nil, fmt.Errorf("series name %s conflicts with the statistic labels", aSeries.Name())
+		}
+
+		fs.lock.RLock()
+		mean, m2, count := fs.moments()
+		sorted := fs.sorted()
+		fs.lock.RUnlock()
+
+		if count == 0 {
+			seriess = append(seriess, NewSeriesFloat64(aSeries.Name(), nil, 0.0, nil, nil, nil, nil, nil, nil, nil))
+			continue
+		}
+
+		var std interface{}
+		if count > 1 {
+			std = math.Sqrt(m2 / float64(count-1))
+		}
+
+		seriess = append(seriess, NewSeriesFloat64(aSeries.Name(), nil,
+			float64(count), mean, std,
+			sorted[0], quantile(sorted, 0.25), quantile(sorted, 0.5), quantile(sorted, 0.75), sorted[len(sorted)-1],
+		))
+	}
+
+	return NewDataFrame(seriess...), nil
+}
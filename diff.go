@@ -0,0 +1,107 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+// Diff returns a new series where each row is the difference between the value
+// and the value periods rows earlier: v[i] - v[i-periods].
+// A negative periods computes the difference with a later row.
+// Rows without a corresponding earlier (or later) row are nil,
+// as are rows where either value is nil.
+func (s *SeriesFloat64) Diff(periods int, options ...Options) *SeriesFloat64 {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	return s.lagged(periods, func(v, prev float64) float64 { return v - prev })
+}
+
+// PctChange returns a new series where each row is the relative change between the value
+// and the value periods rows earlier: (v[i] - v[i-periods]) / v[i-periods].
+// A negative periods computes the change relative to a later row.
+// Rows without a corresponding earlier (or later) row are nil,
+// as are rows where either value is nil.
+func (s *SeriesFloat64) PctChange(periods int, options ...Options) *SeriesFloat64 {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	return s.lagged(periods, func(v, prev float64) float64 { return (v - prev) / prev })
+}
+
+// lagged applies fn to each value and the value periods rows earlier.
+// s must be locked before calling lagged.
+func (s *SeriesFloat64) lagged(periods int, fn func(v, prev float64) float64) *SeriesFloat64 {
+
+	n := len(s.Values)
+	ns := NewSeriesFloat64(s.name, &SeriesInit{Capacity: n})
+
+	for row, v := range s.Values {
+		prevRow := row - periods
+		if prevRow < 0 || prevRow >= n {
+			ns.Append(nil)
+			continue
+		}
+
+		prev := s.Values[prevRow]
+		if isNaN(v) || isNaN(prev) {
+			ns.Append(nil)
+			continue
+		}
+
+		ns.Append(fn(v, prev))
+	}
+
+	return ns
+}
+
+// Shift returns a new series with the values moved down by periods rows.
+// A negative periods moves the values up. The vacated rows are nil.
+// The new series has the same number of rows as s.
+func (s *SeriesFloat64) Shift(periods int, options ...Options) *SeriesFloat64 {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	n := len(s.Values)
+	ns := NewSeriesFloat64(s.name, &SeriesInit{Capacity: n})
+	ns.valFormatter = s.valFormatter
+
+	for row := 0; row < n; row++ {
+		srcRow := row - periods
+		if srcRow < 0 || srcRow >= n {
+			ns.Append(nil)
+		} else {
+			ns.Append(s.Values[srcRow])
+		}
+	}
+
+	return ns
+}
+
+// Shift returns a new series with the values moved down by periods rows.
+// A negative periods moves the values up. The vacated rows are nil.
+// The new series has the same number of rows as s.
+func (s *SeriesInt64) Shift(periods int, options ...Options) *SeriesInt64 {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	n := len(s.values)
+	ns := NewSeriesInt64(s.name, &SeriesInit{Capacity: n})
+	ns.valFormatter = s.valFormatter
+
+	for row := 0; row < n; row++ {
+		srcRow := row - periods
+		if srcRow < 0 || srcRow >= n {
+			ns.Append(nil)
+		} else {
+			ns.Append(s.values[srcRow])
+		}
+	}
+
+	return ns
+}
@@ -0,0 +1,203 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// IsEqualOptions is used to modify the behaviour of IsEqual().
+type IsEqualOptions struct {
+
+	// Don't apply read lock to the dataframes.
+	DontLock bool
+
+	// IgnoreOrder matches series by name instead of by column position.
+	IgnoreOrder bool
+
+	// NaNEqual treats NaN values as equal to each other.
+	// Nil values are always equal. This option is relevant
+	// for non-nil NaN values such as those stored in a SeriesGeneric.
+	NaNEqual bool
+}
+
+// IsEqual returns true if df and other contain the same series (with the same name and type)
+// in the same order, and every row is equal based on each series' IsEqualFunc.
+// An error is returned if IgnoreOrder is set and the series names of a dataframe are not unique.
+//
+// Example:
+//
+//  eq, _ := df.IsEqual(expected)
+//  if !eq {
+//     t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), df.Table())
+//  }
+//
+func (df *DataFrame) IsEqual(other *DataFrame, options ...IsEqualOptions) (bool, error) {
+
+	if df == other {
+		return true, nil
+	}
+
+	var (
+		ignoreOrder bool
+		nanEqual    bool
+	)
+
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+		other.lock.RLock()
+		defer other.lock.RUnlock()
+	}
+
+	if len(options) > 0 {
+		ignoreOrder = options[0].IgnoreOrder
+		nanEqual = options[0].NaNEqual
+	}
+
+	if len(df.Series) != len(other.Series) || df.n != other.n {
+		return false, nil
+	}
+
+	// Determine which series of other corresponds to each series of df
+	pairs := make([]Series, len(df.Series))
+	if ignoreOrder {
+		lookup := map[string]Series{}
+		for _, s := range other.Series {
+			if _, exists := lookup[s.Name()]; exists {
+				return false, fmt.Errorf("series name %s is not unique", s.Name())
+			}
+			lookup[s.Name()] = s
+		}
+
+		seen := map[string]bool{}
+		for idx, s := range df.Series {
+			if seen[s.Name()] {
+				return false, fmt.Errorf("series name %s is not unique", s.Name())
+			}
+			seen[s.Name()] = true
+
+			o, exists := lookup[s.Name()]
+			if !exists {
+				return false, nil
+			}
+			pairs[idx] = o
+		}
+	} else {
+		copy(pairs, other.Series)
+	}
+
+	for idx, s := range df.Series {
+		o := pairs[idx]
+
+		if s.Name() != o.Name() || s.Type() != o.Type() || reflect.TypeOf(s) != reflect.TypeOf(o) {
+			return false, nil
+		}
+
+		for row := 0; row < df.n; row++ {
+			a, b := s.Value(row), o.Value(row)
+			if nanEqual && isNaNValue(a) && isNaNValue(b) {
+				continue
+			}
+			if !s.IsEqualFunc(a, b) {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// isNaNValue returns true if v is a float64 or float32 NaN.
+func isNaNValue(v interface{}) bool {
+	switch f := v.(type) {
+	case float64:
+		return math.IsNaN(f)
+	case float32:
+		return math.IsNaN(float64(f))
+	}
+	return false
+}
+
+// SeriesIsEqualOptions is used to modify the behaviour of SeriesFloat64's IsEqual().
+type SeriesIsEqualOptions struct {
+
+	// Don't apply read lock to the series.
+	DontLock bool
+
+	// CheckName also requires the series names to be equal.
+	CheckName bool
+
+	// NaNEqual treats NaN (nil) values as equal to each other.
+	// Otherwise, following IEEE 754, a NaN is never equal to another value.
+	NaNEqual bool
+
+	// Epsilon is the maximum absolute difference allowed for two values
+	// to be considered equal. If not set, values must be exactly equal.
+	Epsilon float64
+}
+
+// IsEqual returns true if other is a SeriesFloat64 with the same number of rows
+// and every row is equal to the corresponding row of s.
+//
+// Example:
+//
+//  if !s.IsEqual(expected, dataframe.SeriesIsEqualOptions{NaNEqual: true, Epsilon: 1e-9}) {
+//     t.Errorf("wrong val: expected: %v actual: %v", expected, s)
+//  }
+//
+func (s *SeriesFloat64) IsEqual(other Series, options ...SeriesIsEqualOptions) bool {
+
+	o, ok := other.(*SeriesFloat64)
+	if !ok {
+		return false
+	}
+
+	var (
+		checkName bool
+		nanEqual  bool
+		epsilon   float64
+	)
+
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+		if o != s {
+			o.lock.RLock()
+			defer o.lock.RUnlock()
+		}
+	}
+
+	if len(options) > 0 {
+		checkName = options[0].CheckName
+		nanEqual = options[0].NaNEqual
+		epsilon = options[0].Epsilon
+	}
+
+	if checkName && s.name != o.name {
+		return false
+	}
+
+	if len(s.Values) != len(o.Values) {
+		return false
+	}
+
+	for i, a := range s.Values {
+		b := o.Values[i]
+
+		if isNaN(a) || isNaN(b) {
+			if nanEqual && isNaN(a) && isNaN(b) {
+				continue
+			}
+			return false
+		}
+
+		if a != b && math.Abs(a-b) > epsilon {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,107 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ExplodeOptions is used to modify the behaviour of Explode().
+type ExplodeOptions struct {
+	// Don't apply read lock to the dataframe.
+	DontLock bool
+
+	// KeepEmpty will produce a single row containing nil for cells that contain
+	// an empty slice or nil. Otherwise those rows are dropped.
+	KeepEmpty bool
+}
+
+// Explode returns a new dataframe where each element of the slices contained in the series named col
+// is placed in its own row. The values of the other series are duplicated for each element.
+// col must be a SeriesGeneric whose concrete type is a slice (eg. []int64).
+// The exploded series stores the element type of the slice (eg. a SeriesInt64 for []int64).
+//
+// Example:
+//
+//  s := dataframe.NewSeriesGeneric("tags", []string(nil), nil, []string{"a", "b"}, []string{"c"})
+//  df := dataframe.NewDataFrame(dataframe.NewSeriesInt64("id", nil, 1, 2), s)
+//
+//  exploded, _ := dataframe.Explode(ctx, df, "tags")
+//
+func Explode(ctx context.Context, df *DataFrame, col string, options ...ExplodeOptions) (*DataFrame, error) {
+
+	var opts ExplodeOptions
+	if len(options) > 0 {
+		opts = options[0]
+	}
+
+	if !opts.DontLock {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	idx, err := df.NameToColumn(col)
+	if err != nil {
+		return nil, errors.New(err.Error() + ": " + col)
+	}
+
+	gs, ok := df.Series[idx].(*SeriesGeneric)
+	if !ok || reflect.TypeOf(gs.concreteType).Kind() != reflect.Slice {
+		return nil, fmt.Errorf("series does not contain slices: %s", col)
+	}
+
+	elemType := reflect.TypeOf(gs.concreteType).Elem()
+	elem := reflect.Zero(elemType).Interface()
+	if err := checkConcreteType(elem); err != nil {
+		return nil, fmt.Errorf("slice elements must be a concrete type: %s", elemType)
+	}
+
+	exploded := newSeriesFromType(col, elem, &SeriesInit{Capacity: df.n})
+
+	rows := []int{}
+	for row := 0; row < df.n; row++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		val := gs.Value(row)
+
+		var n int
+		if val != nil {
+			n = reflect.ValueOf(val).Len()
+		}
+
+		if n == 0 {
+			if opts.KeepEmpty {
+				rows = append(rows, row)
+				exploded.Append(nil)
+			}
+			continue
+		}
+
+		v := reflect.ValueOf(val)
+		for i := 0; i < n; i++ {
+			rows = append(rows, row)
+			exploded.Append(v.Index(i).Interface())
+		}
+	}
+
+	seriess := []Series{}
+	for i, aSeries := range df.Series {
+		if i == idx {
+			seriess = append(seriess, exploded)
+			continue
+		}
+
+		s, err := subset(ctx, aSeries, rows)
+		if err != nil {
+			return nil, err
+		}
+		seriess = append(seriess, s)
+	}
+
+	return NewDataFrame(seriess...), nil
+}
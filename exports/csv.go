@@ -19,12 +19,15 @@ type CSVExportOptions struct {
 	Range dataframe.Range
 
 	// Separator is the field delimiter. A common option is ',', which is
-	// the default if CSVExportOptions is not provided.
+	// the default if Separator is not set.
 	Separator rune
 
 	// UseCRLF determines the line terminator.
 	// When true, it is set to \r\n.
 	UseCRLF bool
+
+	// OmitHeader will not write the header row containing the series names.
+	OmitHeader bool
 }
 
 // ExportToCSV exports a dataframe to a CSV file.
@@ -42,7 +45,9 @@ func ExportToCSV(ctx context.Context, w io.Writer, df *dataframe.DataFrame, opti
 	cw := csv.NewWriter(w)
 
 	if len(options) > 0 {
-		cw.Comma = options[0].Separator
+		if options[0].Separator != 0 {
+			cw.Comma = options[0].Separator
+		}
 		cw.UseCRLF = options[0].UseCRLF
 		r = options[0].Range
 		if options[0].NullString != nil {
@@ -50,11 +55,13 @@ func ExportToCSV(ctx context.Context, w io.Writer, df *dataframe.DataFrame, opti
 		}
 	}
 
-	for _, aSeries := range df.Series {
-		header = append(header, aSeries.Name())
-	}
-	if err := cw.Write(header); err != nil {
-		return err
+	if len(options) == 0 || !options[0].OmitHeader {
+		for _, aSeries := range df.Series {
+			header = append(header, aSeries.Name())
+		}
+		if err := cw.Write(header); err != nil {
+			return err
+		}
 	}
 
 	nRows := df.NRows(dataframe.DontLock)
@@ -1,6 +1,7 @@
 package exports
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"io"
@@ -22,12 +23,19 @@ type JSONExportOptions struct {
 	// SetEscapeHTML specifies whether problematic HTML characters should be escaped inside JSON quoted strings.
 	// See: https://golang.org/pkg/encoding/json/#Encoder.SetEscapeHTML
 	SetEscapeHTML bool
+
+	// Records will export the dataframe as a JSON array of objects (records orientation)
+	// instead of the jsonl format.
+	Records bool
 }
 
 // ExportToJSON exports a dataframe in the jsonl format.
 // Each line represents a row from the dataframe.
 //
 // See: http://jsonlines.org/ for more information.
+//
+// Values are encoded using their concrete data type so numbers remain numeric.
+// Nil values are encoded as null unless NullString is set.
 func ExportToJSON(ctx context.Context, w io.Writer, df *dataframe.DataFrame, options ...JSONExportOptions) error {
 
 	df.Lock()
@@ -35,12 +43,15 @@ func ExportToJSON(ctx context.Context, w io.Writer, df *dataframe.DataFrame, opt
 
 	var r dataframe.Range
 	var null *string // default is null
+	var records bool
 
-	enc := json.NewEncoder(w)
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
 
 	if len(options) > 0 {
 
 		r = options[0].Range
+		records = options[0].Records
 
 		enc.SetEscapeHTML(options[0].SetEscapeHTML)
 
@@ -49,6 +60,12 @@ func ExportToJSON(ctx context.Context, w io.Writer, df *dataframe.DataFrame, opt
 		}
 	}
 
+	if records {
+		if _, err := io.WriteString(w, "[\n"); err != nil {
+			return err
+		}
+	}
+
 	nRows := df.NRows(dataframe.DontLock)
 
 	if nRows > 0 {
@@ -78,10 +95,27 @@ func ExportToJSON(ctx context.Context, w io.Writer, df *dataframe.DataFrame, opt
 				}
 			}
 
+			buf.Reset()
 			if err := enc.Encode(record); err != nil {
 				return err
 			}
 
+			out := buf.Bytes()
+			if records && row < e {
+				// Separate objects in array
+				out = append(out[:len(out)-1], ',', '\n')
+			}
+
+			if _, err := w.Write(out); err != nil {
+				return err
+			}
+
+		}
+	}
+
+	if records {
+		if _, err := io.WriteString(w, "]\n"); err != nil {
+			return err
 		}
 	}
 
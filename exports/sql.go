@@ -53,6 +53,10 @@ type SQLExportOptions struct {
 
 	// Database is used to set the Database.
 	Database Database
+
+	// CreateTable will create the table (if it does not exist) before inserting data.
+	// The column data types are inferred from the series types.
+	CreateTable bool
 }
 
 // PrimaryKey is used to generate custom values for the primary key
@@ -125,11 +129,12 @@ func ExportToSQL(ctx context.Context, db execContexter, df *dataframe.DataFrame,
 	defer df.Unlock()
 
 	var (
-		null      *string
-		r         dataframe.Range
-		pk        *PrimaryKey
-		batchSize *uint
-		database  Database
+		null        *string
+		r           dataframe.Range
+		pk          *PrimaryKey
+		batchSize   *uint
+		database    Database
+		createTable bool
 	)
 
 	if tableName == "" {
@@ -156,6 +161,7 @@ func ExportToSQL(ctx context.Context, db execContexter, df *dataframe.DataFrame,
 		if database != PostgreSQL && database != MySQL {
 			return errors.New("invalid database")
 		}
+		createTable = options[0].CreateTable
 	}
 
 	nRows := df.NRows(dataframe.DontLock)
@@ -170,12 +176,14 @@ func ExportToSQL(ctx context.Context, db execContexter, df *dataframe.DataFrame,
 
 	// Determine column names
 	columnNames := []string{}
+	columnTypes := []string{}
 
 	if pk != nil {
 		columnNames = append(columnNames, pk.PrimaryKey)
+		columnTypes = append(columnTypes, primaryKeyDDL(database, pk))
 	}
 
-	for _, seriesName := range df.Names() {
+	for idx, seriesName := range df.Names() {
 
 		colName, exists := seriesToColumn[seriesName]
 		if exists && colName == nil {
@@ -190,6 +198,14 @@ func ExportToSQL(ctx context.Context, db execContexter, df *dataframe.DataFrame,
 			// Use provided column name
 			columnNames = append(columnNames, *colName)
 		}
+		columnTypes = append(columnTypes, columnDDL(database, df.Series[idx]))
+	}
+
+	if createTable {
+		err := sqlCreateTable(ctx, db, database, tableName, columnNames, columnTypes)
+		if err != nil {
+			return err
+		}
 	}
 
 	var (
@@ -234,6 +250,13 @@ func ExportToSQL(ctx context.Context, db execContexter, df *dataframe.DataFrame,
 				switch v := val.(type) {
 				case time.Time:
 					ival = &[]string{v.Format("2006-01-02 15:04:05")}[0]
+				case bool:
+					// Both databases accept 1 and 0 for BOOLEAN columns
+					if v {
+						ival = &[]string{"1"}[0]
+					} else {
+						ival = &[]string{"0"}[0]
+					}
 				default:
 					ival = &[]string{series.ValueString(row, dataframe.DontLock)}[0]
 				}
@@ -282,6 +305,63 @@ func sqlInsert(ctx context.Context, db execContexter, database Database, tableNa
 	return nil
 }
 
+func sqlCreateTable(ctx context.Context, db execContexter, database Database, tableName string, columnNames []string, columnTypes []string) error {
+
+	tableName = strings.Join(escapeNames(database, []string{tableName}), ",")
+
+	columns := []string{}
+	for i, name := range escapeNames(database, columnNames) {
+		columns = append(columns, name+" "+columnTypes[i])
+	}
+
+	stmt := "CREATE TABLE IF NOT EXISTS " + tableName + " (" + strings.Join(columns, ",") + ")"
+
+	_, err := db.ExecContext(ctx, stmt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// columnDDL returns the column data type used to store the values of a series.
+func columnDDL(database Database, s dataframe.Series) string {
+	switch s.(type) {
+	case *dataframe.SeriesFloat64:
+		if database == MySQL {
+			return "DOUBLE"
+		}
+		return "DOUBLE PRECISION"
+	case *dataframe.SeriesInt64:
+		return "BIGINT"
+	case *dataframe.SeriesBool:
+		return "BOOLEAN"
+	case *dataframe.SeriesTime:
+		if database == MySQL {
+			return "DATETIME"
+		}
+		return "TIMESTAMP"
+	default:
+		return "TEXT"
+	}
+}
+
+// primaryKeyDDL returns the column definition of the primary key.
+// If no Value function is provided, the primary key is assumed to be auto-incrementing.
+func primaryKeyDDL(database Database, pk *PrimaryKey) string {
+	if pk.Value == nil {
+		if database == MySQL {
+			return "BIGINT AUTO_INCREMENT PRIMARY KEY"
+		}
+		return "BIGSERIAL PRIMARY KEY"
+	}
+
+	if database == MySQL {
+		return "VARCHAR(255) PRIMARY KEY"
+	}
+	return "TEXT PRIMARY KEY"
+}
+
 func placeholders(dbtype Database, fields []string, rows int) string {
 
 	if dbtype == MySQL {
@@ -0,0 +1,68 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package exports
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	dataframe "github.com/rocketlaunchr/dataframe-go"
+)
+
+type recordingDB struct {
+	stmts []string
+	args  [][]interface{}
+}
+
+func (db *recordingDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	db.stmts = append(db.stmts, query)
+	db.args = append(db.args, args)
+	return nil, nil
+}
+
+func TestExportToSQLCreateTable(t *testing.T) {
+	ctx := context.Background()
+
+	df := dataframe.NewDataFrame(
+		dataframe.NewSeriesFloat64("f", nil, 1.5, nil),
+		dataframe.NewSeriesInt64("i", nil, 1, 2),
+		dataframe.NewSeriesBool("b", nil, true, false),
+		dataframe.NewSeriesTime("t", nil, time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC), nil),
+		dataframe.NewSeriesString("s", nil, "a", nil),
+	)
+
+	tests := []struct {
+		database Database
+		create   string
+	}{
+		{PostgreSQL, `CREATE TABLE IF NOT EXISTS "test" ("f" DOUBLE PRECISION,"i" BIGINT,"b" BOOLEAN,"t" TIMESTAMP,"s" TEXT)`},
+		{MySQL, "CREATE TABLE IF NOT EXISTS `test` (`f` DOUBLE,`i` BIGINT,`b` BOOLEAN,`t` DATETIME,`s` TEXT)"},
+	}
+
+	for _, tt := range tests {
+		db := &recordingDB{}
+
+		err := ExportToSQL(ctx, db, df, "test", SQLExportOptions{Database: tt.database, CreateTable: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(db.stmts) != 2 {
+			t.Fatalf("wrong val: expected: %v actual: %v", 2, len(db.stmts))
+		}
+
+		if db.stmts[0] != tt.create {
+			t.Errorf("wrong val: expected: %v actual: %v", tt.create, db.stmts[0])
+		}
+
+		// Values of the bool series (5 columns per row)
+		expected := []string{"1", "0"}
+		actual := []string{*db.args[1][2].(*string), *db.args[1][7].(*string)}
+		if fmt.Sprint(actual) != fmt.Sprint(expected) {
+			t.Errorf("wrong val: expected: %v actual: %v", expected, actual)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+type fillKind int
+
+const (
+	fillConstant fillKind = iota
+	fillForward
+	fillBackward
+	fillLinear
+)
+
+// FillStrategy determines how FillNaN replaces nil values.
+type FillStrategy struct {
+	kind  fillKind
+	value float64
+}
+
+var (
+	// ForwardFill replaces nil values with the last preceding non-nil value.
+	// Leading nil values are left untouched.
+	ForwardFill = FillStrategy{kind: fillForward}
+
+	// BackwardFill replaces nil values with the next succeeding non-nil value.
+	// Trailing nil values are left untouched.
+	BackwardFill = FillStrategy{kind: fillBackward}
+
+	// LinearInterpolation replaces nil values by linearly interpolating between
+	// the surrounding non-nil values. Leading and trailing nil values are left untouched.
+	LinearInterpolation = FillStrategy{kind: fillLinear}
+)
+
+// FillConstant replaces nil values with val.
+func FillConstant(val float64) FillStrategy {
+	return FillStrategy{kind: fillConstant, value: val}
+}
+
+// FillNaN replaces nil values in place based on the strategy.
+//
+// Example:
+//
+//  s.FillNaN(dataframe.ForwardFill)
+//  s.FillNaN(dataframe.FillConstant(0))
+//
+func (s *SeriesFloat64) FillNaN(strategy FillStrategy, options ...Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	if s.nilCount == 0 {
+		return
+	}
+
+	switch strategy.kind {
+	case fillConstant:
+		if isNaN(strategy.value) {
+			return
+		}
+		for i, v := range s.Values {
+			if isNaN(v) {
+				s.Values[i] = strategy.value
+				s.nilCount--
+			}
+		}
+	case fillForward:
+		last := -1
+		for i, v := range s.Values {
+			if !isNaN(v) {
+				last = i
+			} else if last != -1 {
+				s.Values[i] = s.Values[last]
+				s.nilCount--
+			}
+		}
+	case fillBackward:
+		next := -1
+		for i := len(s.Values) - 1; i >= 0; i-- {
+			if !isNaN(s.Values[i]) {
+				next = i
+			} else if next != -1 {
+				s.Values[i] = s.Values[next]
+				s.nilCount--
+			}
+		}
+	case fillLinear:
+		last := -1
+		for i, v := range s.Values {
+			if isNaN(v) {
+				continue
+			}
+			if last != -1 && i-last > 1 {
+				slope := (v - s.Values[last]) / float64(i-last)
+				for j := last + 1; j < i; j++ {
+					s.Values[j] = s.Values[last] + slope*float64(j-last)
+					if !isNaN(s.Values[j]) {
+						// Infinite neighbours can produce NaN
+						s.nilCount--
+					}
+				}
+			}
+			last = i
+		}
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+import (
+	"context"
+)
+
+// FilterOptions is used to modify the behaviour of Filter() and FilterByMask().
+type FilterOptions struct {
+	// Don't apply read lock to the dataframe.
+	DontLock bool
+}
+
+// FilterByMask returns a new dataframe containing only the rows of df where mask is true.
+// A nil value in mask is treated as false. mask must contain the same number of rows as df.
+// Masks can be generated using the comparison methods of a series (eg. GreaterThan)
+// and combined using And, Or, Not and Xor.
+//
+// Example:
+//
+//  mask, _ := sales.GreaterThan(50)
+//  filtered, _ := dataframe.FilterByMask(ctx, df, mask)
+//
+func FilterByMask(ctx context.Context, df *DataFrame, mask *SeriesBool, options ...FilterOptions) (*DataFrame, error) {
+
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	mask.lock.RLock()
+	if len(mask.values) != df.n {
+		mask.lock.RUnlock()
+		return nil, ErrMismatchedRows
+	}
+
+	rows := []int{}
+	for row, v := range mask.values {
+		if v != nil && *v {
+			rows = append(rows, row)
+		}
+	}
+	mask.lock.RUnlock()
+
+	return df.subset(ctx, rows)
+}
+
+// FilterAction is returned by a FilterFn to indicate whether a row should be kept.
+type FilterAction int
+
+const (
+	// Keep signifies that the row should be included in the filtered dataframe.
+	Keep FilterAction = 0
+
+	// Drop signifies that the row should be excluded from the filtered dataframe.
+	Drop FilterAction = 1
+)
+
+// FilterFn is used by Filter to determine which rows to keep.
+// vals contains the values of the row keyed by both the series name and the column number.
+// Returning an error aborts the filtering.
+type FilterFn func(vals map[interface{}]interface{}, row, nRows int) (FilterAction, error)
+
+// Filter returns a new dataframe containing only the rows of df for which fn returns Keep.
+// The order and data types of the series are preserved. df is read locked for the entire operation.
+//
+// Example:
+//
+//  filtered, err := dataframe.Filter(ctx, df, func(vals map[interface{}]interface{}, row, nRows int) (dataframe.FilterAction, error) {
+//     if vals["sales"] == nil {
+//        return dataframe.Drop, nil
+//     }
+//     return dataframe.Keep, nil
+//  })
+//
+func Filter(ctx context.Context, df *DataFrame, fn FilterFn, options ...FilterOptions) (*DataFrame, error) {
+
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	rows := []int{}
+	iterator := df.Values(ValuesOptions{InitialRow: 0, Step: 1, DontReadLock: true})
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		row, vals := iterator()
+		if row == nil {
+			break
+		}
+
+		action, err := fn(vals, *row, df.n)
+		if err != nil {
+			return nil, err
+		}
+
+		if action == Keep {
+			rows = append(rows, *row)
+		}
+	}
+
+	return df.subset(ctx, rows)
+}
+
+// subset returns a new dataframe containing the provided rows of df.
+// df must be locked before calling subset.
+func (df *DataFrame) subset(ctx context.Context, rows []int) (*DataFrame, error) {
+
+	seriess := []Series{}
+	for _, aSeries := range df.Series {
+		s, err := subset(ctx, aSeries, rows)
+		if err != nil {
+			return nil, err
+		}
+		seriess = append(seriess, s)
+	}
+
+	newDF := &DataFrame{
+		Series: seriess,
+		n:      len(rows),
+	}
+
+	return newDF, nil
+}
+
+// subset returns a new series of the same type as s, containing the
+// provided rows in the order given. A row of -1 inserts a nil value.
+// options are used when reading from s.
+func subset(ctx context.Context, s Series, rows []int, options ...Options) (Series, error) {
+
+	var ns Series
+	if s.NRows(options...) == 0 {
+		ns = s.Copy()
+	} else {
+		ns = s.Copy(RangeFinite(0, 0))
+		ns.Remove(0)
+	}
+
+	for _, row := range rows {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if row < 0 {
+			ns.Append(nil)
+		} else {
+			ns.Append(s.Value(row, options...))
+		}
+	}
+
+	return ns, nil
+}
+
+// Filter returns the rows where predicate returns true.
+// predicate receives nil for nil values.
+//
+// Example:
+//
+//  rows := s.Filter(func(val interface{}, row int) bool {
+//     return val != nil && val.(float64) > 50
+//  })
+//
+func (s *SeriesFloat64) Filter(predicate func(val interface{}, row int) bool, options ...Options) []int {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	rows := []int{}
+	for row, v := range s.Values {
+		var val interface{}
+		if !isNaN(v) {
+			val = v
+		}
+		if predicate(val, row) {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// Filter returns the rows where predicate returns true.
+// predicate receives nil for nil values.
+//
+// Example:
+//
+//  rows := s.Filter(func(val interface{}, row int) bool {
+//     return val != nil && val.(int64) > 50
+//  })
+//
+func (s *SeriesInt64) Filter(predicate func(val interface{}, row int) bool, options ...Options) []int {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	rows := []int{}
+	for row, v := range s.values {
+		var val interface{}
+		if v != nil {
+			val = *v
+		}
+		if predicate(val, row) {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
@@ -0,0 +1,165 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package forecast
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	dataframe "github.com/rocketlaunchr/dataframe-go"
+)
+
+// AR forecasts the next m periods using an autoregressive model of order p:
+//
+//  y(t) = c + φ1*y(t-1) + φ2*y(t-2) + ... + φp*y(t-p)
+//
+// The coefficients are estimated using ordinary least squares on the lagged values within the range.
+// The first p rows of the range are only used as lagged values (warmup). Rows where the value or
+// any of its lagged values are nil are excluded from the fit.
+// Forecasts are generated recursively, with each forecast used as a lagged value for the next.
+// The last p values of the range must not be nil.
+// s will be locked for the duration of the operation.
+//
+// See: https://otexts.com/fpp2/AR.html
+func AR(ctx context.Context, s *dataframe.SeriesFloat64, p int, m int, r ...dataframe.Range) (*dataframe.SeriesFloat64, error) {
+
+	if p <= 0 {
+		return nil, errors.New("p must be greater than 0")
+	}
+
+	if m <= 0 {
+		return nil, errors.New("m must be greater than 0")
+	}
+
+	name := s.Name()
+
+	s.Lock()
+	defer s.Unlock()
+
+	start, end, err := limits(s, r...)
+	if err != nil {
+		return nil, err
+	}
+
+	vals := s.Values[start : end+1]
+	n := len(vals)
+
+	if p >= n {
+		return nil, errors.New("p must be less than the number of rows in range")
+	}
+
+	// Build the normal equations (X'X)β = X'y where each row of X is [1, y(t-1), ..., y(t-p)]
+	k := p + 1
+	xtx := make([][]float64, k)
+	for i := range xtx {
+		xtx[i] = make([]float64, k)
+	}
+	xty := make([]float64, k)
+	x := make([]float64, k)
+
+	var obs int
+	for t := p; t < n; t++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if math.IsNaN(vals[t]) {
+			continue
+		}
+
+		x[0] = 1
+		valid := true
+		for i := 1; i <= p; i++ {
+			x[i] = vals[t-i]
+			if math.IsNaN(x[i]) {
+				valid = false
+				break
+			}
+		}
+		if !valid {
+			continue
+		}
+
+		for i := 0; i < k; i++ {
+			for j := 0; j < k; j++ {
+				xtx[i][j] = xtx[i][j] + x[i]*x[j]
+			}
+			xty[i] = xty[i] + x[i]*vals[t]
+		}
+		obs++
+	}
+
+	if obs < k {
+		return nil, errors.New("not enough values in range to fit model")
+	}
+
+	beta, err := solve(xtx, xty)
+	if err != nil {
+		return nil, err
+	}
+
+	// Forecast recursively
+	history := make([]float64, p, p+m)
+	copy(history, vals[n-p:])
+	for _, v := range history {
+		if math.IsNaN(v) {
+			return nil, errors.New("last p values in range must not be nil")
+		}
+	}
+
+	fdf := dataframe.NewSeriesFloat64(name, &dataframe.SeriesInit{Capacity: m})
+	for h := 0; h < m; h++ {
+		f := beta[0]
+		for i := 1; i <= p; i++ {
+			f = f + beta[i]*history[len(history)-i]
+		}
+		history = append(history, f)
+		fdf.Append(f)
+	}
+
+	return fdf, nil
+}
+
+// solve solves the linear system ax = b using Gaussian elimination with partial pivoting.
+// a and b are modified.
+func solve(a [][]float64, b []float64) ([]float64, error) {
+
+	n := len(b)
+
+	for col := 0; col < n; col++ {
+		// Find pivot
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if math.Abs(a[row][col]) > math.Abs(a[pivot][col]) {
+				pivot = row
+			}
+		}
+
+		if math.Abs(a[pivot][col]) < 1e-12 {
+			return nil, errors.New("model can not be fit: values are collinear")
+		}
+
+		a[col], a[pivot] = a[pivot], a[col]
+		b[col], b[pivot] = b[pivot], b[col]
+
+		for row := col + 1; row < n; row++ {
+			factor := a[row][col] / a[col][col]
+			for j := col; j < n; j++ {
+				a[row][j] = a[row][j] - factor*a[col][j]
+			}
+			b[row] = b[row] - factor*b[col]
+		}
+	}
+
+	x := make([]float64, n)
+	for row := n - 1; row >= 0; row-- {
+		sum := b[row]
+		for j := row + 1; j < n; j++ {
+			sum = sum - a[row][j]*x[j]
+		}
+		x[row] = sum / a[row][row]
+	}
+
+	return x, nil
+}
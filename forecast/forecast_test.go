@@ -0,0 +1,381 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package forecast
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	dataframe "github.com/rocketlaunchr/dataframe-go"
+)
+
+var approx = []cmp.Option{cmpopts.EquateNaNs(), cmpopts.EquateApprox(0, 1e-9)}
+
+func TestHoltWinters(t *testing.T) {
+	ctx := context.Background()
+
+	// A purely seasonal series (with no trend or noise) must be reproduced exactly
+	// for any smoothing factors, since every one-step-ahead error is 0.
+	additive := []float64{1, -1, 2, -2}             // sums to 0
+	multiplicative := []float64{0.5, 1.5, 0.8, 1.2} // averages to 1
+
+	sa := dataframe.NewSeriesFloat64("a", nil)
+	sm := dataframe.NewSeriesFloat64("m", nil)
+	for i := 0; i < 12; i++ {
+		sa.Append(10 + additive[i%4])
+		sm.Append(10 * multiplicative[i%4])
+	}
+
+	expectedA := []float64{}
+	expectedM := []float64{}
+	for h := 0; h < 6; h++ {
+		expectedA = append(expectedA, 10+additive[h%4])
+		expectedM = append(expectedM, 10*multiplicative[h%4])
+	}
+
+	actual, err := HoltWinters(ctx, sa, 0.3, 0.2, 0.4, 4, 6, Additive)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !cmp.Equal(actual.Values, expectedA, approx...) {
+		t.Errorf("wrong val: expected: %v actual: %v", expectedA, actual.Values)
+	}
+
+	actual, err = HoltWinters(ctx, sm, 0.3, 0.2, 0.4, 4, 6, Multiplicative)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !cmp.Equal(actual.Values, expectedM, approx...) {
+		t.Errorf("wrong val: expected: %v actual: %v", expectedM, actual.Values)
+	}
+
+	// Reference values calculated by hand for alpha = beta = gamma = 0.5 and period = 2.
+	//
+	// Initialization: level = (1+3)/2 = 2, trend = ((2-1)/2 + (6-3)/2)/2 = 1
+	//
+	// Additive: seasonal = [-1, 1]
+	//  y=2: level = 3, trend = 1, seasonal = [-1, 1]
+	//  y=6: level = 9/2, trend = 5/4, seasonal = [-1, 5/4]
+	//  y=3: level = 39/8, trend = 13/16, seasonal = [-23/16, 5/4]
+	//  forecast = [39/8 + 13/16 + 5/4, 39/8 + 2*13/16 - 23/16]
+	//
+	// Multiplicative: seasonal = [1/2, 3/2]
+	//  y=2: level = 7/2, trend = 5/4, seasonal = [15/28, 3/2]
+	//  y=6: level = 35/8, trend = 17/16, seasonal = [15/28, 201/140]
+	//  y=3: level = 883/160, trend = 353/320, seasonal = [26685/49448, 201/140]
+	//  forecast = [(883/160 + 353/320) * 201/140, (883/160 + 2*353/320) * 26685/49448]
+	s := dataframe.NewSeriesFloat64("s", nil, 1.0, 3.0, 2.0, 6.0, 3.0)
+
+	tests := []struct {
+		seasonalType SeasonalType
+		expected     []float64
+	}{
+		{Additive, []float64{111.0 / 16, 81.0 / 16}},
+		{Multiplicative, []float64{425919.0 / 44800, 1649133.0 / 395584}},
+	}
+
+	for i, tc := range tests {
+		actual, err := HoltWinters(ctx, s, 0.5, 0.5, 0.5, 2, 2, tc.seasonalType)
+		if err != nil {
+			t.Fatalf("%d: unexpected error: %v", i, err)
+		}
+		if !cmp.Equal(actual.Values, tc.expected, approx...) {
+			t.Errorf("%d: wrong val: expected: %v actual: %v", i, tc.expected, actual.Values)
+		}
+	}
+
+	// Fewer than 2 full periods
+	short := dataframe.NewSeriesFloat64("s", nil, 1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0)
+	if _, err := HoltWinters(ctx, short, 0.5, 0.5, 0.5, 4, 2, Additive); err == nil {
+		t.Errorf("expected error for fewer than 2 periods")
+	}
+}
+
+func TestHoltLinear(t *testing.T) {
+	ctx := context.Background()
+
+	// A linear series is reproduced exactly
+	s := dataframe.NewSeriesFloat64("s", nil)
+	for i := 0; i < 10; i++ {
+		s.Append(float64(2*i + 1))
+	}
+
+	actual, err := HoltLinear(ctx, s, 0.5, 0.5, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []float64{21, 23, 25}
+	if !cmp.Equal(actual.Values, expected, approx...) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual.Values)
+	}
+}
+
+func TestAR(t *testing.T) {
+	ctx := context.Background()
+
+	// y(t) = 1 + 0.5*y(t-1) is recovered exactly by AR(1)
+	s := dataframe.NewSeriesFloat64("s", nil)
+	y := 10.0
+	for i := 0; i < 10; i++ {
+		s.Append(y)
+		y = 1 + 0.5*y
+	}
+
+	actual, err := AR(ctx, s, 1, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []float64{}
+	for i := 0; i < 3; i++ {
+		expected = append(expected, y)
+		y = 1 + 0.5*y
+	}
+
+	if !cmp.Equal(actual.Values, expected, approx...) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual.Values)
+	}
+}
+
+func TestSES(t *testing.T) {
+	ctx := context.Background()
+
+	// level: 10 => 15 => 12.5 (nil skipped)
+	s := dataframe.NewSeriesFloat64("s", nil, 10.0, 20.0, nil, 10.0)
+
+	actual, err := SES(ctx, s, 0.5, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []float64{12.5, 12.5}
+	if !cmp.Equal(actual.Values, expected, approx...) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual.Values)
+	}
+
+	_, fitted, err := SESWithFitted(ctx, s, 0.5, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedFitted := []float64{math.NaN(), 10, 15, 15}
+	if !cmp.Equal(fitted.Values, expectedFitted, approx...) {
+		t.Errorf("wrong val: expected: %v actual: %v", expectedFitted, fitted.Values)
+	}
+}
+
+func TestSESConfidence(t *testing.T) {
+	ctx := context.Background()
+
+	s := dataframe.NewSeriesFloat64("s", nil, 10.0, 20.0, nil, 10.0)
+
+	// residuals: 10, -5
+	_, metrics, err := SESConfidence(ctx, s, 0.5, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedMetrics := ErrorMetrics{N: 2, SSE: 125, MSE: 62.5, RMSE: math.Sqrt(62.5), MAE: 7.5}
+	if !cmp.Equal(metrics, expectedMetrics, approx...) {
+		t.Errorf("wrong val: expected: %v actual: %v", expectedMetrics, metrics)
+	}
+}
+
+func TestSESAuto(t *testing.T) {
+	ctx := context.Background()
+
+	// A step change is best tracked with alpha close to 1
+	s := dataframe.NewSeriesFloat64("s", nil, 0.0, 0.0, 0.0, 10.0, 10.0, 10.0, 10.0)
+
+	actual, alpha, err := SESAuto(ctx, s, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if alpha < 0.9 || alpha > 1 || actual.NRows() != 2 {
+		t.Errorf("wrong val: expected: %v actual: %v %v", "alpha > 0.9", alpha, actual.Values)
+	}
+
+	_, warm, err := SESAutoWarmStart(ctx, s, alpha, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if math.Abs(warm-alpha) > warmStartRadius {
+		t.Errorf("wrong val: expected: %v actual: %v", alpha, warm)
+	}
+}
+
+func TestIntervals(t *testing.T) {
+	ctx := context.Background()
+
+	s := dataframe.NewSeriesFloat64("s", nil, 10.0, 12.0, 9.0, 11.0, 13.0, 10.0, 12.0, 11.0, 9.0, 14.0, 11.0, 12.0)
+
+	check := func(name string, f, lower, upper *dataframe.SeriesFloat64, err error) {
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		prevWidth := 0.0
+		for i := range f.Values {
+			width := upper.Values[i] - lower.Values[i]
+			if !(lower.Values[i] < f.Values[i] && f.Values[i] < upper.Values[i]) || width <= prevWidth {
+				t.Errorf("%s: wrong val: expected: %v actual: %v", name, "widening intervals around the forecast", []float64{lower.Values[i], f.Values[i], upper.Values[i]})
+			}
+			prevWidth = width
+		}
+	}
+
+	f, lower, upper, err := SESWithIntervals(ctx, s, 0.3, 3, 0.95)
+	check("SESWithIntervals", f, lower, upper, err)
+
+	f, lower, upper, err = HoltWintersWithIntervals(ctx, s, 0.3, 0.1, 0.2, 3, 3, Additive, 0.95)
+	check("HoltWintersWithIntervals", f, lower, upper, err)
+
+	if _, _, _, err := SESWithIntervals(ctx, s, 0.3, 3, 1.5); err == nil {
+		t.Errorf("expected error for confidence out of range")
+	}
+}
+
+func TestKalmanSmooth(t *testing.T) {
+	ctx := context.Background()
+
+	s := dataframe.NewSeriesFloat64("s", nil, nil, 5.0, 5.0, nil, 5.0)
+
+	actual, err := KalmanSmooth(ctx, s, 0.1, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []float64{math.NaN(), 5, 5, 5, 5}
+	if !cmp.Equal(actual.Values, expected, approx...) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual.Values)
+	}
+}
+
+func TestMovingAverage(t *testing.T) {
+	ctx := context.Background()
+
+	s := dataframe.NewSeriesFloat64("s", nil, 1.0, 2.0, 3.0, 4.0)
+
+	actual, err := MovingAverage(ctx, s, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []float64{math.NaN(), 1.5, 2.5, 3.5}
+	if !cmp.Equal(actual.Values, expected, approx...) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual.Values)
+	}
+
+	actual, err = WeightedMovingAverage(ctx, s, []float64{1, 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected = []float64{math.NaN(), 1.75, 2.75, 3.75}
+	if !cmp.Equal(actual.Values, expected, approx...) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual.Values)
+	}
+}
+
+func TestNaive(t *testing.T) {
+	ctx := context.Background()
+
+	actual, err := Naive(ctx, dataframe.NewSeriesFloat64("s", nil, 1.0, 2.0, nil), 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []float64{2, 2}
+	if !cmp.Equal(actual.Values, expected, approx...) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual.Values)
+	}
+
+	actual, err = SeasonalNaive(ctx, dataframe.NewSeriesFloat64("s", nil, 1.0, 2.0, 3.0, 4.0, 5.0, 6.0), 3, 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected = []float64{4, 5, 6, 4}
+	if !cmp.Equal(actual.Values, expected, approx...) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual.Values)
+	}
+}
+
+func TestOnlineSES(t *testing.T) {
+
+	o, err := NewOnlineSES(0.5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if o.Forecast(2) != nil {
+		t.Errorf("wrong val: expected: %v actual: %v", nil, o.Forecast(2))
+	}
+
+	o.Update(10)
+	o.Update(math.NaN())
+	o.Update(20)
+
+	expected := []float64{15, 15}
+	if !cmp.Equal(o.Forecast(2), expected, approx...) || o.N() != 2 {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, o.Forecast(2))
+	}
+
+	if _, err := NewOnlineSES(2); err == nil {
+		t.Errorf("expected error for alpha out of range")
+	}
+}
+
+func TestStreamSES(t *testing.T) {
+	ctx := context.Background()
+
+	in := make(chan float64)
+	out := StreamSES(ctx, in, 0.5, 2)
+
+	go func() {
+		for _, y := range []float64{math.NaN(), 10, 20} {
+			in <- y
+		}
+		close(in)
+	}()
+
+	actual := [][]float64{}
+	for f := range out {
+		actual = append(actual, f)
+	}
+
+	// No forecast is emitted for the leading NaN
+	expected := [][]float64{{10, 10}, {15, 15}}
+	if !cmp.Equal(actual, expected, approx...) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual)
+	}
+
+	// Output is closed when ctx is canceled
+	cctx, cancel := context.WithCancel(ctx)
+	out = StreamSES(cctx, make(chan float64), 0.5, 2)
+	cancel()
+	if _, ok := <-out; ok {
+		t.Errorf("expected output to be closed")
+	}
+
+	// Invalid parameters
+	for _, tc := range []struct {
+		alpha float64
+		m     int
+	}{{2, 1}, {0.5, 0}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for alpha: %v m: %v", tc.alpha, tc.m)
+				}
+			}()
+			StreamSES(ctx, in, tc.alpha, tc.m)
+		}()
+	}
+}
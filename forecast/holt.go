@@ -0,0 +1,83 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package forecast
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	dataframe "github.com/rocketlaunchr/dataframe-go"
+)
+
+// HoltLinear forecasts the next m periods using Holt's linear trend method (double exponential smoothing).
+// alpha is the smoothing factor for the level and beta is the smoothing factor for the trend.
+// The level is initialized with the first non-nil value and the trend is initialized with the
+// difference between the first two non-nil values within the range. Nil values are skipped.
+// s will be locked for the duration of the operation.
+//
+// See: https://otexts.com/fpp2/holt.html
+func HoltLinear(ctx context.Context, s *dataframe.SeriesFloat64, alpha, beta float64, m int, r ...dataframe.Range) (*dataframe.SeriesFloat64, error) {
+
+	if alpha < 0 || alpha > 1 {
+		return nil, errors.New("alpha must be between [0,1]")
+	}
+
+	if beta < 0 || beta > 1 {
+		return nil, errors.New("beta must be between [0,1]")
+	}
+
+	if m <= 0 {
+		return nil, errors.New("m must be greater than 0")
+	}
+
+	name := s.Name()
+
+	s.Lock()
+	defer s.Unlock()
+
+	start, end, err := limits(s, r...)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		level, trend float64
+		n            int
+	)
+
+	for i := start; i <= end; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		y := s.Values[i]
+		if math.IsNaN(y) {
+			continue
+		}
+
+		switch n {
+		case 0:
+			level = y
+		case 1:
+			trend = y - level
+			level = y
+		default:
+			prevLevel := level
+			level = alpha*y + (1-alpha)*(level+trend)
+			trend = beta*(level-prevLevel) + (1-beta)*trend
+		}
+		n++
+	}
+
+	if n < 2 {
+		return nil, errors.New("range must contain at least 2 values")
+	}
+
+	fdf := dataframe.NewSeriesFloat64(name, &dataframe.SeriesInit{Capacity: m})
+	for h := 1; h <= m; h++ {
+		fdf.Append(level + float64(h)*trend)
+	}
+
+	return fdf, nil
+}
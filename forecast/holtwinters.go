@@ -0,0 +1,158 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package forecast
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	dataframe "github.com/rocketlaunchr/dataframe-go"
+)
+
+// SeasonalType determines how the seasonal component is combined with the level and trend.
+type SeasonalType int
+
+const (
+	// Additive is used when the seasonal variations are roughly constant through the series.
+	Additive SeasonalType = 0
+
+	// Multiplicative is used when the seasonal variations change proportionally to the level of the series.
+	Multiplicative SeasonalType = 1
+)
+
+// HoltWinters forecasts the next m periods using the Holt-Winters seasonal method (triple exponential smoothing).
+// alpha, beta and gamma are the smoothing factors for the level, trend and seasonal components respectively.
+// period is the number of rows in a season.
+//
+// The level is initialized with the mean of the first period. The trend is initialized with the average
+// change between the first and second periods. The seasonal indices are initialized from the first period.
+// The range must contain at least two full periods and the first two periods must not contain nil values.
+// A nil value after the first two periods is replaced with its one-step-ahead forecast.
+// s will be locked for the duration of the operation.
+//
+// See: https://otexts.com/fpp2/holt-winters.html
+func HoltWinters(ctx context.Context, s *dataframe.SeriesFloat64, alpha, beta, gamma float64, period int, m int, seasonalType SeasonalType, r ...dataframe.Range) (*dataframe.SeriesFloat64, error) {
+
+	name := s.Name()
+
+	s.Lock()
+	defer s.Unlock()
+
+	forecast, _, err := holtWinters(ctx, s, name, alpha, beta, gamma, period, m, seasonalType, r...)
+	return forecast, err
+}
+
+// holtWinters is the implementation of HoltWinters. It also returns the in-sample one-step-ahead residuals.
+// s must be locked before calling holtWinters.
+func holtWinters(ctx context.Context, s *dataframe.SeriesFloat64, name string, alpha, beta, gamma float64, period int, m int, seasonalType SeasonalType, r ...dataframe.Range) (*dataframe.SeriesFloat64, []float64, error) {
+
+	if alpha < 0 || alpha > 1 {
+		return nil, nil, errors.New("alpha must be between [0,1]")
+	}
+
+	if beta < 0 || beta > 1 {
+		return nil, nil, errors.New("beta must be between [0,1]")
+	}
+
+	if gamma < 0 || gamma > 1 {
+		return nil, nil, errors.New("gamma must be between [0,1]")
+	}
+
+	if period <= 1 {
+		return nil, nil, errors.New("period must be greater than 1")
+	}
+
+	if m <= 0 {
+		return nil, nil, errors.New("m must be greater than 0")
+	}
+
+	if seasonalType != Additive && seasonalType != Multiplicative {
+		return nil, nil, errors.New("unknown seasonal type")
+	}
+
+	start, end, err := limits(s, r...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vals := s.Values[start : end+1]
+
+	if len(vals) < 2*period {
+		return nil, nil, errors.New("range must contain at least two full periods")
+	}
+
+	for _, v := range vals[:2*period] {
+		if math.IsNaN(v) {
+			return nil, nil, errors.New("first two periods of range must not contain nil values")
+		}
+	}
+
+	// Initialize level, trend and seasonal indices
+	var level, trend float64
+	for i := 0; i < period; i++ {
+		level = level + vals[i]
+		trend = trend + (vals[period+i]-vals[i])/float64(period)
+	}
+	level = level / float64(period)
+	trend = trend / float64(period)
+
+	if seasonalType == Multiplicative && level == 0 {
+		return nil, nil, errors.New("multiplicative seasonality requires a non-zero level")
+	}
+
+	seasonal := make([]float64, period)
+	for i := 0; i < period; i++ {
+		if seasonalType == Additive {
+			seasonal[i] = vals[i] - level
+		} else {
+			seasonal[i] = vals[i] / level
+		}
+	}
+
+	residuals := []float64{}
+
+	// Smooth the remaining values
+	for i := period; i < len(vals); i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		idx := i % period
+		y := vals[i]
+
+		if seasonalType == Additive {
+			if math.IsNaN(y) {
+				y = level + trend + seasonal[idx]
+			} else {
+				residuals = append(residuals, y-(level+trend+seasonal[idx]))
+			}
+			prevLevel := level
+			level = alpha*(y-seasonal[idx]) + (1-alpha)*(level+trend)
+			trend = beta*(level-prevLevel) + (1-beta)*trend
+			seasonal[idx] = gamma*(y-level) + (1-gamma)*seasonal[idx]
+		} else {
+			if math.IsNaN(y) {
+				y = (level + trend) * seasonal[idx]
+			} else {
+				residuals = append(residuals, y-(level+trend)*seasonal[idx])
+			}
+			prevLevel := level
+			level = alpha*(y/seasonal[idx]) + (1-alpha)*(level+trend)
+			trend = beta*(level-prevLevel) + (1-beta)*trend
+			seasonal[idx] = gamma*(y/level) + (1-gamma)*seasonal[idx]
+		}
+	}
+
+	fdf := dataframe.NewSeriesFloat64(name, &dataframe.SeriesInit{Capacity: m})
+	for h := 1; h <= m; h++ {
+		idx := (len(vals) + h - 1) % period
+		if seasonalType == Additive {
+			fdf.Append(level + float64(h)*trend + seasonal[idx])
+		} else {
+			fdf.Append((level + float64(h)*trend) * seasonal[idx])
+		}
+	}
+
+	return fdf, residuals, nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package forecast
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	dataframe "github.com/rocketlaunchr/dataframe-go"
+)
+
+// SESWithIntervals is the same as SES except it also returns the lower and upper bounds of the
+// prediction intervals at the provided confidence level (eg. 0.95 for 95%).
+// See predictionIntervals for how the bounds are calculated.
+// s will be locked for the duration of the operation.
+//
+// Example:
+//
+//  forecast, lower, upper, err := forecast.SESWithIntervals(ctx, s, 0.3, 5, 0.95)
+//
+func SESWithIntervals(ctx context.Context, s *dataframe.SeriesFloat64, alpha float64, m int, confidence float64, r ...dataframe.Range) (*dataframe.SeriesFloat64, *dataframe.SeriesFloat64, *dataframe.SeriesFloat64, error) {
+
+	if confidence <= 0 || confidence >= 1 {
+		return nil, nil, nil, errors.New("confidence must be between (0,1)")
+	}
+
+	name := s.Name()
+
+	s.Lock()
+	defer s.Unlock()
+
+	forecast, fitted, err := sesWithFitted(ctx, s, name, alpha, m, r...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	start, end, _ := limits(s, r...)
+
+	residuals := []float64{}
+	for i, y := range s.Values[start : end+1] {
+		f := fitted.Values[i]
+		if !math.IsNaN(y) && !math.IsNaN(f) {
+			residuals = append(residuals, y-f)
+		}
+	}
+
+	lower, upper, err := predictionIntervals(forecast, residuals, confidence)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return forecast, lower, upper, nil
+}
+
+// HoltWintersWithIntervals is the same as HoltWinters except it also returns the lower and upper bounds of the
+// prediction intervals at the provided confidence level (eg. 0.95 for 95%).
+// See predictionIntervals for how the bounds are calculated.
+// s will be locked for the duration of the operation.
+func HoltWintersWithIntervals(ctx context.Context, s *dataframe.SeriesFloat64, alpha, beta, gamma float64, period int, m int, seasonalType SeasonalType, confidence float64, r ...dataframe.Range) (*dataframe.SeriesFloat64, *dataframe.SeriesFloat64, *dataframe.SeriesFloat64, error) {
+
+	if confidence <= 0 || confidence >= 1 {
+		return nil, nil, nil, errors.New("confidence must be between (0,1)")
+	}
+
+	name := s.Name()
+
+	s.Lock()
+	defer s.Unlock()
+
+	forecast, residuals, err := holtWinters(ctx, s, name, alpha, beta, gamma, period, m, seasonalType, r...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	lower, upper, err := predictionIntervals(forecast, residuals, confidence)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return forecast, lower, upper, nil
+}
+
+// predictionIntervals returns the lower and upper bounds of the prediction intervals for the
+// forecasted values. It assumes that the one-step-ahead residuals are uncorrelated and normally
+// distributed with a mean of 0. The residual standard deviation σ is estimated from the residuals
+// and the bounds for the forecast h periods ahead are calculated as:
+//
+//  forecast(h) ± z * σ * √h
+//
+// where z is the quantile of the standard normal distribution for the confidence level.
+// The √h factor is an approximation that widens the intervals as the uncertainty of
+// later forecasts accumulates.
+//
+// See: https://otexts.com/fpp2/prediction-intervals.html
+func predictionIntervals(forecast *dataframe.SeriesFloat64, residuals []float64, confidence float64) (*dataframe.SeriesFloat64, *dataframe.SeriesFloat64, error) {
+
+	if len(residuals) == 0 {
+		return nil, nil, errors.New("not enough values in range to estimate prediction intervals")
+	}
+
+	var sse float64
+	for _, e := range residuals {
+		sse = sse + e*e
+	}
+	sigma := math.Sqrt(sse / float64(len(residuals)))
+
+	// Two-sided quantile of the standard normal distribution
+	z := math.Sqrt2 * math.Erfinv(confidence)
+
+	name := forecast.Name()
+	m := len(forecast.Values)
+
+	lower := dataframe.NewSeriesFloat64(name, &dataframe.SeriesInit{Capacity: m})
+	upper := dataframe.NewSeriesFloat64(name, &dataframe.SeriesInit{Capacity: m})
+
+	for i, f := range forecast.Values {
+		width := z * sigma * math.Sqrt(float64(i+1))
+		lower.Append(f - width)
+		upper.Append(f + width)
+	}
+
+	return lower, upper, nil
+}
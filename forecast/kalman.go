@@ -0,0 +1,83 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package forecast
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	dataframe "github.com/rocketlaunchr/dataframe-go"
+)
+
+// KalmanSmooth applies a 1-D Kalman filter to s, treating each value as a noisy
+// measurement of an underlying random walk. processVar is the variance of the random
+// walk between rows and measVar is the variance of the measurement noise.
+//
+// The returned series is aligned to the range and contains the filtered estimate for each row.
+// The estimate is initialized with the first non-nil value. Prior to that, the estimate is nil.
+// For nil observations, the prediction is propagated without an update.
+// s will be locked for the duration of the operation.
+//
+// See: https://en.wikipedia.org/wiki/Kalman_filter
+func KalmanSmooth(ctx context.Context, s *dataframe.SeriesFloat64, processVar, measVar float64, r ...dataframe.Range) (*dataframe.SeriesFloat64, error) {
+
+	if processVar < 0 {
+		return nil, errors.New("processVar must not be negative")
+	}
+
+	if measVar <= 0 {
+		return nil, errors.New("measVar must be greater than 0")
+	}
+
+	name := s.Name()
+
+	s.Lock()
+	defer s.Unlock()
+
+	start, end, err := limits(s, r...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := dataframe.NewSeriesFloat64(name, &dataframe.SeriesInit{Capacity: end - start + 1})
+
+	var (
+		initialized bool
+		x           float64 // estimate
+		p           float64 // estimate variance
+	)
+
+	for i := start; i <= end; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		y := s.Values[i]
+
+		if !initialized {
+			if math.IsNaN(y) {
+				out.Append(nil)
+				continue
+			}
+			initialized = true
+			x, p = y, measVar
+			out.Append(x)
+			continue
+		}
+
+		// Predict
+		p = p + processVar
+
+		// Update
+		if !math.IsNaN(y) {
+			k := p / (p + measVar)
+			x = x + k*(y-x)
+			p = (1 - k) * p
+		}
+
+		out.Append(x)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package forecast
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	dataframe "github.com/rocketlaunchr/dataframe-go"
+)
+
+// MovingAverage smooths the values within the range by averaging each value with the
+// preceding period-1 values. The returned series is aligned to the range.
+// The first period-1 rows are nil, as are rows whose window contains a nil value.
+// s will be locked for the duration of the operation.
+//
+// See: https://otexts.com/fpp2/moving-averages.html
+func MovingAverage(ctx context.Context, s *dataframe.SeriesFloat64, period int, r ...dataframe.Range) (*dataframe.SeriesFloat64, error) {
+
+	if period <= 0 {
+		return nil, errors.New("period must be greater than 0")
+	}
+
+	weights := make([]float64, period)
+	for i := range weights {
+		weights[i] = 1
+	}
+
+	return WeightedMovingAverage(ctx, s, weights, r...)
+}
+
+// WeightedMovingAverage smooths the values within the range by calculating the weighted average
+// of each value and the preceding len(weights)-1 values. The last weight is applied to the current
+// value and the first weight is applied to the oldest value. The weights are normalized so that
+// they sum to 1. The returned series is aligned to the range.
+// The first len(weights)-1 rows are nil, as are rows whose window contains a nil value.
+// s will be locked for the duration of the operation.
+//
+// Example:
+//
+//  smoothed, err := forecast.WeightedMovingAverage(ctx, s, []float64{1, 2, 3})
+//
+func WeightedMovingAverage(ctx context.Context, s *dataframe.SeriesFloat64, weights []float64, r ...dataframe.Range) (*dataframe.SeriesFloat64, error) {
+
+	if len(weights) == 0 {
+		return nil, errors.New("weights must not be empty")
+	}
+
+	var total float64
+	for _, w := range weights {
+		total = total + w
+	}
+
+	if total == 0 {
+		return nil, errors.New("weights must not sum to 0")
+	}
+
+	name := s.Name()
+
+	s.Lock()
+	defer s.Unlock()
+
+	start, end, err := limits(s, r...)
+	if err != nil {
+		return nil, err
+	}
+
+	vals := s.Values[start : end+1]
+	period := len(weights)
+
+	fdf := dataframe.NewSeriesFloat64(name, &dataframe.SeriesInit{Capacity: len(vals)})
+
+	for i := range vals {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if i < period-1 {
+			fdf.Append(nil)
+			continue
+		}
+
+		var sum float64
+		window := vals[i-period+1 : i+1]
+		for j, v := range window {
+			sum = sum + weights[j]*v
+		}
+
+		if math.IsNaN(sum) {
+			fdf.Append(nil)
+		} else {
+			fdf.Append(sum / total)
+		}
+	}
+
+	return fdf, nil
+}
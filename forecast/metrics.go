@@ -0,0 +1,62 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package forecast
+
+import (
+	"math"
+
+	dataframe "github.com/rocketlaunchr/dataframe-go"
+)
+
+// ErrorMetrics describes how well a model fits the data it was trained on.
+// The metrics are calculated from the in-sample one-step-ahead residuals.
+type ErrorMetrics struct {
+	// N is the number of residuals used to calculate the metrics.
+	N int
+
+	// SSE is the sum of squared errors.
+	SSE float64
+
+	// MSE is the mean squared error.
+	MSE float64
+
+	// RMSE is the root mean squared error.
+	RMSE float64
+
+	// MAE is the mean absolute error.
+	MAE float64
+}
+
+// errorMetrics calculates the error metrics from the actual and fitted values.
+// Rows where either value is nil are skipped. If there are no residuals, all metrics (except N) are NaN.
+// actual and fitted must be locked (if required) before calling errorMetrics.
+func errorMetrics(actual []float64, fitted *dataframe.SeriesFloat64) ErrorMetrics {
+
+	var (
+		em  ErrorMetrics
+		sae float64
+	)
+
+	for i, y := range actual {
+		f := fitted.Values[i]
+		if math.IsNaN(y) || math.IsNaN(f) {
+			continue
+		}
+
+		e := y - f
+		em.SSE = em.SSE + e*e
+		sae = sae + math.Abs(e)
+		em.N++
+	}
+
+	if em.N == 0 {
+		nan := math.NaN()
+		return ErrorMetrics{SSE: nan, MSE: nan, RMSE: nan, MAE: nan}
+	}
+
+	em.MSE = em.SSE / float64(em.N)
+	em.RMSE = math.Sqrt(em.MSE)
+	em.MAE = sae / float64(em.N)
+
+	return em
+}
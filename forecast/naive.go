@@ -0,0 +1,119 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package forecast
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	dataframe "github.com/rocketlaunchr/dataframe-go"
+)
+
+// Naive is a baseline forecasting method that repeats the last observed value
+// for all m forecasted periods. It is the standard reference that other
+// forecasting models should be compared against.
+// The last non-nil value within the range is used.
+// s will be locked for the duration of the operation.
+//
+// See: https://otexts.com/fpp2/simple-methods.html
+func Naive(ctx context.Context, s *dataframe.SeriesFloat64, m int, r ...dataframe.Range) (*dataframe.SeriesFloat64, error) {
+
+	if m <= 0 {
+		return nil, errors.New("m must be greater than 0")
+	}
+
+	name := s.Name()
+
+	s.Lock()
+	defer s.Unlock()
+
+	start, end, err := limits(s, r...)
+	if err != nil {
+		return nil, err
+	}
+
+	last := math.NaN()
+	for i := end; i >= start; i-- {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if !math.IsNaN(s.Values[i]) {
+			last = s.Values[i]
+			break
+		}
+	}
+
+	if math.IsNaN(last) {
+		return nil, errors.New("no values found in range")
+	}
+
+	fdf := dataframe.NewSeriesFloat64(name, &dataframe.SeriesInit{Capacity: m})
+	for i := 0; i < m; i++ {
+		fdf.Append(last)
+	}
+
+	return fdf, nil
+}
+
+// SeasonalNaive is a baseline forecasting method for seasonal data. Each forecasted
+// period is set to the observed value from the same season in the last full period.
+// A nil observation results in a nil forecast for the corresponding season.
+// s will be locked for the duration of the operation.
+//
+// See: https://otexts.com/fpp2/simple-methods.html
+func SeasonalNaive(ctx context.Context, s *dataframe.SeriesFloat64, period, m int, r ...dataframe.Range) (*dataframe.SeriesFloat64, error) {
+
+	if period <= 0 {
+		return nil, errors.New("period must be greater than 0")
+	}
+
+	if m <= 0 {
+		return nil, errors.New("m must be greater than 0")
+	}
+
+	name := s.Name()
+
+	s.Lock()
+	defer s.Unlock()
+
+	start, end, err := limits(s, r...)
+	if err != nil {
+		return nil, err
+	}
+
+	if end-start+1 < period {
+		return nil, errors.New("range must contain at least one full period")
+	}
+
+	// The last full season
+	season := s.Values[end-period+1 : end+1]
+
+	fdf := dataframe.NewSeriesFloat64(name, &dataframe.SeriesInit{Capacity: m})
+	for i := 0; i < m; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		fdf.Append(season[i%period])
+	}
+
+	return fdf, nil
+}
+
+// limits returns the start and end rows of s based on r.
+// s must be locked before calling limits.
+func limits(s *dataframe.SeriesFloat64, r ...dataframe.Range) (int, int, error) {
+
+	if len(r) == 0 {
+		r = append(r, dataframe.Range{})
+	}
+
+	nRows := s.NRows(dataframe.DontLock)
+	if nRows == 0 {
+		return 0, 0, dataframe.ErrNoRows
+	}
+
+	return r[0].Limits(nRows)
+}
@@ -0,0 +1,119 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package forecast
+
+import (
+	"context"
+	"errors"
+	"math"
+)
+
+// OnlineSES maintains the state of a simple exponential smoothing model that
+// is updated one observation at a time. It is useful when observations arrive
+// incrementally and refitting the entire series is wasteful.
+type OnlineSES struct {
+	alpha float64
+	level float64
+	n     int
+}
+
+// NewOnlineSES creates a new OnlineSES. alpha is the smoothing factor and must be
+// between 0 and 1 (inclusive).
+func NewOnlineSES(alpha float64) (*OnlineSES, error) {
+	if alpha < 0 || alpha > 1 {
+		return nil, errors.New("alpha must be between [0,1]")
+	}
+
+	return &OnlineSES{alpha: alpha}, nil
+}
+
+// Update incorporates a new observation into the model.
+// The first observation initializes the level. NaN observations are ignored.
+func (o *OnlineSES) Update(y float64) {
+	if math.IsNaN(y) {
+		return
+	}
+
+	if o.n == 0 {
+		o.level = y
+	} else {
+		o.level = o.alpha*y + (1-o.alpha)*o.level
+	}
+	o.n++
+}
+
+// N returns the number of observations incorporated into the model.
+func (o *OnlineSES) N() int {
+	return o.n
+}
+
+// Forecast returns the forecast for the next m periods.
+// nil is returned if no observations have been incorporated.
+func (o *OnlineSES) Forecast(m int) []float64 {
+	if o.n == 0 {
+		return nil
+	}
+
+	out := make([]float64, m)
+	for i := range out {
+		out[i] = o.level
+	}
+	return out
+}
+
+// StreamSES forecasts the next m periods each time an observation is received from in,
+// using simple exponential smoothing with smoothing factor alpha.
+// The returned channel is closed when in is closed or ctx is canceled.
+// No forecast is emitted until the first non-NaN observation is received.
+// StreamSES will panic if alpha is not between 0 and 1 (inclusive) or m is not greater than 0.
+//
+// Example:
+//
+//  forecasts := forecast.StreamSES(ctx, observations, 0.3, 5)
+//  for f := range forecasts {
+//     fmt.Println(f)
+//  }
+//
+func StreamSES(ctx context.Context, in <-chan float64, alpha float64, m int) <-chan []float64 {
+
+	if m <= 0 {
+		panic("m must be greater than 0")
+	}
+
+	o, err := NewOnlineSES(alpha)
+	if err != nil {
+		panic(err)
+	}
+
+	out := make(chan []float64)
+
+	go func() {
+		defer close(out)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case y, ok := <-in:
+				if !ok {
+					return
+				}
+
+				o.Update(y)
+
+				f := o.Forecast(m)
+				if f == nil {
+					continue
+				}
+
+				select {
+				case out <- f:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
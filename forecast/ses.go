@@ -0,0 +1,242 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package forecast
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	dataframe "github.com/rocketlaunchr/dataframe-go"
+)
+
+// SES forecasts the next m periods using simple exponential smoothing with
+// smoothing factor alpha. The level is initialized with the first non-nil value
+// within the range. Nil values are skipped.
+// s will be locked for the duration of the operation.
+//
+// See: https://otexts.com/fpp2/ses.html
+func SES(ctx context.Context, s *dataframe.SeriesFloat64, alpha float64, m int, r ...dataframe.Range) (*dataframe.SeriesFloat64, error) {
+	forecast, _, err := SESWithFitted(ctx, s, alpha, m, r...)
+	return forecast, err
+}
+
+// SESWithFitted is the same as SES except it also returns the in-sample fitted values.
+// The fitted series is aligned to the range and contains the one-step-ahead forecast for each row
+// (i.e. the level prior to observing that row). It is nil until the level has been initialized.
+// The fitted values can be used to plot against the actual values and to calculate residuals.
+// s will be locked for the duration of the operation.
+//
+// Example:
+//
+//  forecast, fitted, err := forecast.SESWithFitted(ctx, s, 0.3, 5)
+//
+func SESWithFitted(ctx context.Context, s *dataframe.SeriesFloat64, alpha float64, m int, r ...dataframe.Range) (*dataframe.SeriesFloat64, *dataframe.SeriesFloat64, error) {
+
+	name := s.Name()
+
+	s.Lock()
+	defer s.Unlock()
+
+	return sesWithFitted(ctx, s, name, alpha, m, r...)
+}
+
+// sesWithFitted is the implementation of SESWithFitted.
+// s must be locked before calling sesWithFitted.
+func sesWithFitted(ctx context.Context, s *dataframe.SeriesFloat64, name string, alpha float64, m int, r ...dataframe.Range) (*dataframe.SeriesFloat64, *dataframe.SeriesFloat64, error) {
+
+	if m <= 0 {
+		return nil, nil, errors.New("m must be greater than 0")
+	}
+
+	o, err := NewOnlineSES(alpha)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	start, end, err := limits(s, r...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fitted := dataframe.NewSeriesFloat64(name, &dataframe.SeriesInit{Capacity: end - start + 1})
+
+	for i := start; i <= end; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		if o.N() == 0 {
+			fitted.Append(nil)
+		} else {
+			fitted.Append(o.level)
+		}
+
+		o.Update(s.Values[i])
+	}
+
+	if o.N() == 0 {
+		return nil, nil, errors.New("no values found in range")
+	}
+
+	forecast := dataframe.NewSeriesFloat64(name, &dataframe.SeriesInit{Capacity: m})
+	for _, v := range o.Forecast(m) {
+		forecast.Append(v)
+	}
+
+	return forecast, fitted, nil
+}
+
+// SESConfidence is the same as SES except it also returns error metrics calculated from the
+// in-sample one-step-ahead residuals within the range. The metrics can be used to compare
+// different values of alpha.
+// s will be locked for the duration of the operation.
+//
+// Example:
+//
+//  forecast, metrics, err := forecast.SESConfidence(ctx, s, 0.3, 5)
+//  fmt.Println(metrics.RMSE)
+//
+func SESConfidence(ctx context.Context, s *dataframe.SeriesFloat64, alpha float64, m int, r ...dataframe.Range) (*dataframe.SeriesFloat64, ErrorMetrics, error) {
+
+	name := s.Name()
+
+	s.Lock()
+	defer s.Unlock()
+
+	forecast, fitted, err := sesWithFitted(ctx, s, name, alpha, m, r...)
+	if err != nil {
+		return nil, ErrorMetrics{}, err
+	}
+
+	start, end, _ := limits(s, r...)
+
+	return forecast, errorMetrics(s.Values[start:end+1], fitted), nil
+}
+
+// SESAuto is the same as SES except the smoothing factor is automatically chosen by minimizing
+// the sum of squared one-step-ahead errors within the range. A golden-section search is performed
+// over the entire [0,1] interval. The chosen alpha is returned so that it can be provided as the
+// hint to SESAutoWarmStart when the model is refit with new data.
+// s will be locked for the duration of the operation.
+//
+// Example:
+//
+//  forecast, alpha, err := forecast.SESAuto(ctx, s, 5)
+//
+//  // Later, after new data has arrived
+//  forecast, alpha, err = forecast.SESAutoWarmStart(ctx, s, alpha, 5)
+//
+func SESAuto(ctx context.Context, s *dataframe.SeriesFloat64, m int, r ...dataframe.Range) (*dataframe.SeriesFloat64, float64, error) {
+	return sesAuto(ctx, s, 0, 1, m, r...)
+}
+
+// SESAutoWarmStart is the same as SESAuto except the golden-section search is restricted to a
+// local neighborhood of hint (typically the alpha previously chosen by SESAuto).
+// This makes refitting the model cheap when new data arrives and the optimal alpha
+// is not expected to change substantially.
+// s will be locked for the duration of the operation.
+func SESAutoWarmStart(ctx context.Context, s *dataframe.SeriesFloat64, hint float64, m int, r ...dataframe.Range) (*dataframe.SeriesFloat64, float64, error) {
+
+	if hint < 0 || hint > 1 {
+		return nil, 0, errors.New("hint must be between [0,1]")
+	}
+
+	return sesAuto(ctx, s, math.Max(0, hint-warmStartRadius), math.Min(1, hint+warmStartRadius), m, r...)
+}
+
+// warmStartRadius is the size of the neighborhood searched by SESAutoWarmStart on either side of the hint.
+const warmStartRadius = 0.1
+
+func sesAuto(ctx context.Context, s *dataframe.SeriesFloat64, lo, hi float64, m int, r ...dataframe.Range) (*dataframe.SeriesFloat64, float64, error) {
+
+	if m <= 0 {
+		return nil, 0, errors.New("m must be greater than 0")
+	}
+
+	name := s.Name()
+
+	s.Lock()
+	defer s.Unlock()
+
+	start, end, err := limits(s, r...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	vals := s.Values[start : end+1]
+
+	alpha, err := goldenSection(ctx, func(alpha float64) float64 {
+		return sesSSE(vals, alpha)
+	}, lo, hi)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	o, _ := NewOnlineSES(alpha)
+	for _, y := range vals {
+		o.Update(y)
+	}
+
+	if o.N() == 0 {
+		return nil, 0, errors.New("no values found in range")
+	}
+
+	forecast := dataframe.NewSeriesFloat64(name, &dataframe.SeriesInit{Capacity: m})
+	for _, v := range o.Forecast(m) {
+		forecast.Append(v)
+	}
+
+	return forecast, alpha, nil
+}
+
+// sesSSE returns the sum of squared one-step-ahead errors of simple exponential smoothing.
+func sesSSE(vals []float64, alpha float64) float64 {
+
+	o := OnlineSES{alpha: alpha}
+
+	var sse float64
+	for _, y := range vals {
+		if math.IsNaN(y) {
+			continue
+		}
+		if o.N() > 0 {
+			e := y - o.level
+			sse = sse + e*e
+		}
+		o.Update(y)
+	}
+
+	return sse
+}
+
+// goldenSection returns the value within [lo, hi] that minimizes f, assuming f is unimodal.
+//
+// See: https://en.wikipedia.org/wiki/Golden-section_search
+func goldenSection(ctx context.Context, f func(float64) float64, lo, hi float64) (float64, error) {
+
+	const tol = 1e-5
+	invPhi := (math.Sqrt(5) - 1) / 2
+
+	c := hi - invPhi*(hi-lo)
+	d := lo + invPhi*(hi-lo)
+	fc, fd := f(c), f(d)
+
+	for hi-lo > tol {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		if fc < fd {
+			hi, d, fd = d, c, fc
+			c = hi - invPhi*(hi-lo)
+			fc = f(c)
+		} else {
+			lo, c, fc = c, d, fd
+			d = lo + invPhi*(hi-lo)
+			fd = f(d)
+		}
+	}
+
+	return (lo + hi) / 2, nil
+}
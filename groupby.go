@@ -0,0 +1,337 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// GroupByOptions is used to modify the behaviour of GroupBy().
+type GroupByOptions struct {
+	// Don't apply read lock to the dataframe.
+	DontLock bool
+}
+
+// Groups contains the rows of a dataframe partitioned by the values of one or more series.
+// It is created using GroupBy(). The dataframe must not be modified while Groups is in use.
+type Groups struct {
+	df      *DataFrame
+	keys    []Series
+	isKey   map[string]struct{}
+	groups  [][]int // rows of each group in order of first appearance
+	options []GroupByOptions
+}
+
+// AggFn aggregates the values of s at the provided rows into a single value.
+// A nil value signifies that the aggregated value is nil.
+type AggFn func(s Series, rows []int) (interface{}, error)
+
+// GroupBy partitions the rows of df based on the values of the series named in cols.
+// Rows with the same combination of values belong to the same group. nil is treated
+// as a value, so rows with nil values form their own groups.
+// The groups are ordered by their first appearance in df.
+//
+// Example:
+//
+//  groups, err := dataframe.GroupBy(ctx, df, []string{"region"})
+//  summary, err := groups.Aggregate(ctx, map[string]dataframe.AggFn{
+//     "sales": dataframe.AggSum,
+//     "price": dataframe.AggMean,
+//  })
+//
+func GroupBy(ctx context.Context, df *DataFrame, cols []string, options ...GroupByOptions) (*Groups, error) {
+
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	if len(cols) == 0 {
+		return nil, errors.New("at least 1 series must be provided")
+	}
+
+	g := &Groups{
+		df:      df,
+		isKey:   map[string]struct{}{},
+		options: options,
+	}
+
+	for _, name := range cols {
+		if _, exists := g.isKey[name]; exists {
+			return nil, fmt.Errorf("duplicate series: %s", name)
+		}
+
+		idx, err := df.NameToColumn(name)
+		if err != nil {
+			return nil, errors.New(err.Error() + ": " + name)
+		}
+		g.keys = append(g.keys, df.Series[idx])
+		g.isKey[name] = struct{}{}
+	}
+
+	// The hash only determines the candidate groups since distinct
+	// values (eg. of a SeriesGeneric) can have the same hash.
+	lookup := map[string][]int{}
+	for row := 0; row < df.n; row++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		hash := groupHash(g.keys, row)
+
+		idx := -1
+		for _, candidate := range lookup[hash] {
+			if groupKeysEqual(g.keys, g.groups[candidate][0], row) {
+				idx = candidate
+				break
+			}
+		}
+
+		if idx == -1 {
+			idx = len(g.groups)
+			lookup[hash] = append(lookup[hash], idx)
+			g.groups = append(g.groups, []int{})
+		}
+		g.groups[idx] = append(g.groups[idx], row)
+	}
+
+	return g, nil
+}
+
+// NGroups returns the number of groups.
+func (g *Groups) NGroups() int {
+	return len(g.groups)
+}
+
+// Aggregate returns a new dataframe containing one row per group. The dataframe contains the
+// series used to group the rows, followed by the aggregated series (ordered by name).
+// aggs maps the name of each series to be aggregated to its aggregation function.
+// The data type of each aggregated series is determined by the first non-nil aggregated value.
+// If all aggregated values are nil, a SeriesFloat64 is used.
+func (g *Groups) Aggregate(ctx context.Context, aggs map[string]AggFn) (*DataFrame, error) {
+
+	df := g.df
+
+	if len(g.options) == 0 || (len(g.options) > 0 && !g.options[0].DontLock) {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	names := []string{}
+	for name := range aggs {
+		if _, exists := g.isKey[name]; exists {
+			return nil, fmt.Errorf("can not aggregate grouped series: %s", name)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// Group series
+	firstRows := make([]int, 0, len(g.groups))
+	for _, rows := range g.groups {
+		firstRows = append(firstRows, rows[0])
+	}
+
+	seriess := []Series{}
+	for _, key := range g.keys {
+		s, err := subset(ctx, key, firstRows)
+		if err != nil {
+			return nil, err
+		}
+		seriess = append(seriess, s)
+	}
+
+	// Aggregated series
+	init := &SeriesInit{Capacity: len(g.groups)}
+	for _, name := range names {
+		idx, err := df.NameToColumn(name)
+		if err != nil {
+			return nil, errors.New(err.Error() + ": " + name)
+		}
+
+		vals := make([]interface{}, 0, len(g.groups))
+		var typ interface{}
+		for _, rows := range g.groups {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			val, err := aggs[name](df.Series[idx], rows)
+			if err != nil {
+				return nil, err
+			}
+			if typ == nil {
+				typ = val
+			}
+			vals = append(vals, val)
+		}
+
+		if typ == nil {
+			typ = float64(0)
+		}
+
+		s := newSeriesFromType(name, typ, init)
+		for _, val := range vals {
+			s.Append(val)
+		}
+		seriess = append(seriess, s)
+	}
+
+	return NewDataFrame(seriess...), nil
+}
+
+// groupHash returns a hash of the values of keys at row.
+func groupHash(keys []Series, row int) string {
+
+	parts := make([]string, 0, len(keys))
+	for _, s := range keys {
+		hashes := joinHashes([]Series{s}, row, 0, false)
+		if hashes == nil {
+			parts = append(parts, "\x01") // nil
+		} else {
+			parts = append(parts, "\x02"+hashes[0])
+		}
+	}
+
+	return strings.Join(parts, "\x00")
+}
+
+// groupKeysEqual returns true if the values of keys at row1 and row2 are equal.
+// Unlike Join, nil values are equal to each other.
+func groupKeysEqual(keys []Series, row1, row2 int) bool {
+	for _, s := range keys {
+		a, b := s.Value(row1), s.Value(row2)
+		if a == nil || b == nil {
+			if (a == nil) != (b == nil) {
+				return false
+			}
+			continue
+		}
+
+		if !s.IsEqualFunc(a, b) {
+			return false
+		}
+	}
+	return true
+}
+
+var (
+	// AggCount returns the number of non-nil values as an int64.
+	// It supports all series.
+	AggCount AggFn = func(s Series, rows []int) (interface{}, error) {
+		var count int64
+		for _, row := range rows {
+			if s.Value(row) != nil {
+				count++
+			}
+		}
+		return count, nil
+	}
+
+	// AggSum returns the sum of the non-nil values. The sum of a SeriesInt64 is an int64 and
+	// the sum of a SeriesFloat64 is a float64. The sum of no values is 0.
+	AggSum AggFn = func(s Series, rows []int) (interface{}, error) {
+		switch s.(type) {
+		case *SeriesInt64:
+			var sum int64
+			for _, row := range rows {
+				if v := s.Value(row); v != nil {
+					sum = sum + v.(int64)
+				}
+			}
+			return sum, nil
+		case *SeriesFloat64:
+			var sum float64
+			for _, row := range rows {
+				if v := s.Value(row); v != nil {
+					sum = sum + v.(float64)
+				}
+			}
+			return sum, nil
+		default:
+			return nil, fmt.Errorf("sum not supported for series type: %s", s.Type())
+		}
+	}
+
+	// AggMean returns the mean of the non-nil values as a float64.
+	// The mean of no values is nil.
+	AggMean AggFn = func(s Series, rows []int) (interface{}, error) {
+		vals, err := aggNumericValues(s, rows)
+		if err != nil {
+			return nil, err
+		}
+		if len(vals) == 0 {
+			return nil, nil
+		}
+
+		var sum float64
+		for _, v := range vals {
+			sum = sum + v
+		}
+		return sum / float64(len(vals)), nil
+	}
+
+	// AggMin returns the smallest non-nil value. The data type is the same as the series.
+	// The minimum of no values is nil.
+	AggMin AggFn = func(s Series, rows []int) (interface{}, error) {
+		return aggExtreme(s, rows, func(a, b interface{}) bool { return s.IsLessThanFunc(a, b) })
+	}
+
+	// AggMax returns the largest non-nil value. The data type is the same as the series.
+	// The maximum of no values is nil.
+	AggMax AggFn = func(s Series, rows []int) (interface{}, error) {
+		return aggExtreme(s, rows, func(a, b interface{}) bool { return s.IsLessThanFunc(b, a) })
+	}
+)
+
+// aggNumericValues returns the non-nil values of a numeric series at the provided rows.
+func aggNumericValues(s Series, rows []int) ([]float64, error) {
+
+	vals := make([]float64, 0, len(rows))
+
+	switch s.(type) {
+	case *SeriesInt64:
+		for _, row := range rows {
+			if v := s.Value(row); v != nil {
+				vals = append(vals, float64(v.(int64)))
+			}
+		}
+	case *SeriesFloat64:
+		for _, row := range rows {
+			if v := s.Value(row); v != nil {
+				vals = append(vals, v.(float64))
+			}
+		}
+	default:
+		return nil, fmt.Errorf("series type not numeric: %s", s.Type())
+	}
+
+	return vals, nil
+}
+
+// aggExtreme returns the first non-nil value for which better returns true against
+// all other non-nil values. Only numeric series are supported.
+func aggExtreme(s Series, rows []int, better func(a, b interface{}) bool) (interface{}, error) {
+
+	switch s.(type) {
+	case *SeriesInt64, *SeriesFloat64:
+	default:
+		return nil, fmt.Errorf("series type not numeric: %s", s.Type())
+	}
+
+	var extreme interface{}
+	for _, row := range rows {
+		v := s.Value(row)
+		if v == nil {
+			continue
+		}
+		if extreme == nil || better(v, extreme) {
+			extreme = v
+		}
+	}
+	return extreme, nil
+}
@@ -5,6 +5,7 @@ package dataframe
 import (
 	"errors"
 	"fmt"
+	"sort"
 )
 
 // ErrNoRows signifies that the Series, Dataframe or import data
@@ -48,3 +49,47 @@ func BoolValueFormatter(v interface{}) string {
 
 // DontLock is short-hand for various functions that permit disabling locking.
 var DontLock = Options{DontLock: true}
+
+// sortedRows returns a sorted copy of rows after checking that each row
+// is unique and within the range [0, nRows).
+func sortedRows(rows []int, nRows int) ([]int, error) {
+
+	sorted := make([]int, len(rows))
+	copy(sorted, rows)
+	sort.Ints(sorted)
+
+	for i, row := range sorted {
+		if row < 0 || row >= nRows {
+			return nil, fmt.Errorf("row out of range: %d", row)
+		}
+		if i > 0 && sorted[i-1] == row {
+			return nil, fmt.Errorf("duplicate row: %d", row)
+		}
+	}
+
+	return sorted, nil
+}
+
+// headTail returns a copy of the first (or last if tail is set) n rows of s.
+// s must be locked before calling headTail.
+func headTail(s Series, n int, tail bool) Series {
+
+	nRows := s.NRows(DontLock)
+	if n > nRows {
+		n = nRows
+	}
+
+	if n <= 0 {
+		if nRows == 0 {
+			return s.Copy()
+		}
+		ns := s.Copy(RangeFinite(0, 0))
+		ns.Remove(0)
+		return ns
+	}
+
+	if tail {
+		return s.Copy(RangeFinite(nRows-n, nRows-1))
+	}
+	return s.Copy(RangeFinite(0, n-1))
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"strings"
 	"time"
 
 	dataframe "github.com/rocketlaunchr/dataframe-go"
@@ -51,6 +52,87 @@ type CSVLoadOptions struct {
 	//
 	// Common values are: NULL, \N, NaN, NA
 	NilValue *string
+
+	// NilValues is the same as NilValue except multiple string values can be interpreted as a nil value.
+	NilValues []string
+
+	// NumericCleaning, if set, is used to clean values before they are parsed into
+	// a float64 or int64 (as dictated by DictateDataType).
+	// It is useful for financial data containing values such as "$1,234.56" and "(500)".
+	NumericCleaning *NumericCleaning
+
+	// InferDataTypes will determine the data type of each field that is not dictated by DictateDataType
+	// by performing a basic parse of the full dataset before processing the data fully.
+	// The following rules are applied:
+	//
+	// 1. int64 if every value is an integer that fits in an int64. Integers that lose precision as a float64 remain exact.
+	//
+	// 2. float64 if every value is numeric but some values are not integers or some integers don't fit in an int64 (see OverflowToString).
+	//
+	// 3. bool if every value is true, TRUE, false or FALSE. Unlike DictateDataType, inferred bools are stored in a SeriesBool.
+	//
+	// 4. time.Time if every value is formatted using RFC3339.
+	//
+	// 5. string otherwise (including fields that contain a mixture of the above).
+	//
+	// Empty values (and NilValue/NilValues) are ignored when inferring the data type and are stored as nil in inferred fields.
+	InferDataTypes bool
+
+	// OverflowToString will infer a field as a string instead of a float64 when it contains integers
+	// that don't fit in an int64. This preserves every digit of large ids.
+	OverflowToString bool
+}
+
+// NumericCleaning is used to remove formatting from numeric values before they are parsed.
+type NumericCleaning struct {
+
+	// CurrencySymbols are removed from the value. eg. "$", "€", "USD"
+	CurrencySymbols []string
+
+	// ThousandsSeparator, if not 0, is removed from the value. eg. ','
+	ThousandsSeparator rune
+
+	// ParenthesesNegative will interpret a value enclosed in parentheses as negative. eg. "(500)" => -500
+	// A value enclosed in parentheses that already has a sign (eg. "(-500)") can't be parsed.
+	ParenthesesNegative bool
+
+	// MaxErrors is the number of values that can't be parsed (after cleaning) that are stored as nil.
+	// Once exceeded, an error is returned. If MaxErrors is negative, there is no limit.
+	// The default of 0 returns an error for the first value that can't be parsed.
+	MaxErrors int
+}
+
+// clean removes the formatting from v. An error is returned if v is enclosed in parentheses
+// and already has a sign.
+func (nc *NumericCleaning) clean(v string) (string, error) {
+
+	v = strings.TrimSpace(v)
+
+	var negative bool
+	if nc.ParenthesesNegative && len(v) >= 2 && strings.HasPrefix(v, "(") && strings.HasSuffix(v, ")") {
+		negative = true
+		v = strings.TrimSpace(v[1 : len(v)-1])
+		if strings.HasPrefix(v, "-") || strings.HasPrefix(v, "+") {
+			return "", fmt.Errorf("signed value %s enclosed in parentheses", v)
+		}
+	}
+
+	for _, sym := range nc.CurrencySymbols {
+		if sym != "" {
+			v = strings.Replace(v, sym, "", -1)
+		}
+	}
+
+	if nc.ThousandsSeparator != 0 {
+		v = strings.Replace(v, string(nc.ThousandsSeparator), "", -1)
+	}
+
+	v = strings.TrimSpace(v)
+
+	if negative {
+		return "-" + v, nil
+	}
+	return v, nil
 }
 
 // LoadFromCSV will load data from a csv file.
@@ -58,6 +140,9 @@ func LoadFromCSV(ctx context.Context, r io.ReadSeeker, options ...CSVLoadOptions
 
 	var init *dataframe.SeriesInit
 
+	var dictate map[string]interface{}
+	inferred := map[string]bool{}
+
 	cr := csv.NewReader(r)
 	cr.ReuseRecord = true
 	if len(options) > 0 {
@@ -65,6 +150,26 @@ func LoadFromCSV(ctx context.Context, r io.ReadSeeker, options ...CSVLoadOptions
 		cr.Comment = options[0].Comment
 		cr.TrimLeadingSpace = options[0].TrimLeadingSpace
 
+		dictate = options[0].DictateDataType
+
+		if options[0].InferDataTypes {
+			types, err := inferCSVDataTypes(ctx, cr, options[0])
+			if err != nil {
+				return nil, err
+			}
+			r.Seek(0, io.SeekStart)
+
+			dictate = map[string]interface{}{}
+			for name, typ := range types {
+				dictate[name] = typ
+				inferred[name] = true
+			}
+			for name, typ := range options[0].DictateDataType {
+				dictate[name] = typ
+				delete(inferred, name)
+			}
+		}
+
 		// Count how many rows we have in order to preallocate underlying slices
 		if options[0].LargeDataSet {
 			init = &dataframe.SeriesInit{}
@@ -92,6 +197,21 @@ func LoadFromCSV(ctx context.Context, r io.ReadSeeker, options ...CSVLoadOptions
 	var row int
 	var df *dataframe.DataFrame
 
+	// numericErr returns nil if a value that can't be parsed should be stored as nil
+	// (as permitted by NumericCleaning.MaxErrors). Otherwise it returns the error.
+	var numErrors int
+	numericErr := func(typ string, row int, name string, cleanErr error) error {
+		nc := options[0].NumericCleaning
+		if nc != nil && (nc.MaxErrors < 0 || numErrors < nc.MaxErrors) {
+			numErrors++
+			return nil
+		}
+		if cleanErr != nil {
+			return fmt.Errorf("can't force string to %s (%v). row: %d field: %s", typ, cleanErr, row, name)
+		}
+		return fmt.Errorf("can't force string to %s. row: %d field: %s", typ, row, name)
+	}
+
 	for {
 		if err := ctx.Err(); err != nil {
 			return nil, err
@@ -114,9 +234,9 @@ func LoadFromCSV(ctx context.Context, r io.ReadSeeker, options ...CSVLoadOptions
 			for _, name := range rec {
 
 				// Check if we know what the datatype should be. Otherwise assume string
-				if len(options) > 0 && len(options[0].DictateDataType) > 0 {
+				if len(dictate) > 0 {
 
-					typ, exists := options[0].DictateDataType[name]
+					typ, exists := dictate[name]
 					if !exists {
 						seriess = append(seriess, dataframe.NewSeriesString(name, init))
 						continue
@@ -125,7 +245,13 @@ func LoadFromCSV(ctx context.Context, r io.ReadSeeker, options ...CSVLoadOptions
 					switch T := typ.(type) {
 					case float64:
 						seriess = append(seriess, dataframe.NewSeriesFloat64(name, init))
-					case int64, bool:
+					case bool:
+						if inferred[name] {
+							seriess = append(seriess, dataframe.NewSeriesBool(name, init))
+						} else {
+							seriess = append(seriess, dataframe.NewSeriesInt64(name, init))
+						}
+					case int64:
 						seriess = append(seriess, dataframe.NewSeriesInt64(name, init))
 					case string:
 						seriess = append(seriess, dataframe.NewSeriesString(name, init))
@@ -150,19 +276,23 @@ func LoadFromCSV(ctx context.Context, r io.ReadSeeker, options ...CSVLoadOptions
 			for idx, v := range rec {
 
 				// Check if v represents a nil value
-				if len(options) > 0 && options[0].NilValue != nil {
-					if v == *options[0].NilValue {
-						insertVals = append(insertVals, nil)
-						continue
-					}
+				if len(options) > 0 && options[0].isNil(v) {
+					insertVals = append(insertVals, nil)
+					continue
 				}
 
-				if len(options) > 0 && len(options[0].DictateDataType) > 0 {
+				if len(dictate) > 0 {
 
 					name := df.Names()[idx]
 
+					// Empty values of inferred fields are nil
+					if v == "" && inferred[name] {
+						insertVals = append(insertVals, nil)
+						continue
+					}
+
 					// Check if a datatype is dictated
-					typ, exists := options[0].DictateDataType[name]
+					typ, exists := dictate[name]
 					if !exists {
 						// Store value as a string
 						insertVals = append(insertVals, v)
@@ -172,7 +302,9 @@ func LoadFromCSV(ctx context.Context, r io.ReadSeeker, options ...CSVLoadOptions
 						case string:
 							insertVals = append(insertVals, v)
 						case bool:
-							if v == "TRUE" || v == "true" || v == "1" {
+							if inferred[name] {
+								insertVals = append(insertVals, v == "TRUE" || v == "true")
+							} else if v == "TRUE" || v == "true" || v == "1" {
 								insertVals = append(insertVals, int64(1))
 							} else if v == "FALSE" || v == "false" || v == "0" {
 								insertVals = append(insertVals, int64(0))
@@ -180,15 +312,31 @@ func LoadFromCSV(ctx context.Context, r io.ReadSeeker, options ...CSVLoadOptions
 								return nil, fmt.Errorf("can't force string to bool. row: %d field: %s", row-1, name)
 							}
 						case int64:
+							var cErr error
+							if options[0].NumericCleaning != nil {
+								v, cErr = options[0].NumericCleaning.clean(v)
+							}
 							i, err := strconv.ParseInt(v, 10, 64)
-							if err != nil {
-								return nil, fmt.Errorf("can't force string to int64. row: %d field: %s", row-1, name)
+							if cErr != nil || err != nil {
+								if err := numericErr("int64", row-1, name, cErr); err != nil {
+									return nil, err
+								}
+								insertVals = append(insertVals, nil)
+								break
 							}
 							insertVals = append(insertVals, i)
 						case float64:
+							var cErr error
+							if options[0].NumericCleaning != nil {
+								v, cErr = options[0].NumericCleaning.clean(v)
+							}
 							f, err := strconv.ParseFloat(v, 64)
-							if err != nil {
-								return nil, fmt.Errorf("can't force string to float64. row: %d field: %s", row-1, name)
+							if cErr != nil || err != nil {
+								if err := numericErr("float64", row-1, name, cErr); err != nil {
+									return nil, err
+								}
+								insertVals = append(insertVals, nil)
+								break
 							}
 							insertVals = append(insertVals, f)
 						case time.Time:
@@ -200,8 +348,9 @@ func LoadFromCSV(ctx context.Context, r io.ReadSeeker, options ...CSVLoadOptions
 									return nil, fmt.Errorf("can't force string to time.Time (%s). row: %d field: %s", time.RFC3339, row-1, name)
 								}
 								insertVals = append(insertVals, time.Unix(sec, 0))
+							} else {
+								insertVals = append(insertVals, t)
 							}
-							insertVals = append(insertVals, t)
 						case Converter:
 							cv, err := T.ConverterFunc(v)
 							if err != nil {
@@ -236,3 +385,128 @@ func LoadFromCSV(ctx context.Context, r io.ReadSeeker, options ...CSVLoadOptions
 
 	return df, nil
 }
+
+// inferCSVDataTypes determines the data type of each field based on the rules documented in CSVLoadOptions.
+// Fields inferred to be strings are not returned.
+func inferCSVDataTypes(ctx context.Context, cr *csv.Reader, opts CSVLoadOptions) (map[string]interface{}, error) {
+
+	const (
+		unknown = iota
+		isInt
+		isFloat
+		isBool
+		isTime
+		isString
+	)
+
+	var (
+		names    []string
+		kinds    []int
+		overflow []bool
+	)
+
+	for row := 0; ; row++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		rec, err := cr.Read()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+
+		if row == 0 {
+			// First row contains headings
+			names = append(names, rec...)
+			kinds = make([]int, len(rec))
+			overflow = make([]bool, len(rec))
+			continue
+		}
+
+		for idx, v := range rec {
+			if v == "" || kinds[idx] == isString || opts.isNil(v) {
+				continue
+			}
+
+			var kind int
+
+			switch v {
+			case "true", "TRUE", "false", "FALSE":
+				kind = isBool
+			default:
+				if _, err := time.Parse(time.RFC3339, v); err == nil {
+					kind = isTime
+					break
+				}
+
+				if opts.NumericCleaning != nil {
+					var err error
+					if v, err = opts.NumericCleaning.clean(v); err != nil {
+						kind = isString
+						break
+					}
+				}
+
+				_, err := strconv.ParseInt(v, 10, 64)
+				if err == nil {
+					kind = isInt
+					break
+				}
+
+				if nErr, ok := err.(*strconv.NumError); ok && nErr.Err == strconv.ErrRange {
+					overflow[idx] = true
+				}
+
+				if _, err := strconv.ParseFloat(v, 64); err == nil {
+					kind = isFloat
+				} else {
+					kind = isString
+				}
+			}
+
+			switch {
+			case kinds[idx] == unknown || kinds[idx] == kind:
+				kinds[idx] = kind
+			case (kinds[idx] == isInt && kind == isFloat) || (kinds[idx] == isFloat && kind == isInt):
+				kinds[idx] = isFloat
+			default:
+				kinds[idx] = isString
+			}
+		}
+	}
+
+	out := map[string]interface{}{}
+	for idx, name := range names {
+		switch kinds[idx] {
+		case isInt:
+			out[name] = int64(0)
+		case isFloat:
+			if overflow[idx] && opts.OverflowToString {
+				continue
+			}
+			out[name] = float64(0)
+		case isBool:
+			out[name] = false
+		case isTime:
+			out[name] = time.Time{}
+		}
+	}
+
+	return out, nil
+}
+
+// isNil returns true if v represents a nil value based on NilValue and NilValues.
+func (opts CSVLoadOptions) isNil(v string) bool {
+	if opts.NilValue != nil && v == *opts.NilValue {
+		return true
+	}
+	for _, nv := range opts.NilValues {
+		if v == nv {
+			return true
+		}
+	}
+	return false
+}
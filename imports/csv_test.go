@@ -0,0 +1,159 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package imports
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	dataframe "github.com/rocketlaunchr/dataframe-go"
+)
+
+func TestLoadFromCSVNumericCleaning(t *testing.T) {
+	ctx := context.Background()
+
+	nc := &NumericCleaning{
+		CurrencySymbols:     []string{"$"},
+		ThousandsSeparator:  ',',
+		ParenthesesNegative: true,
+	}
+
+	csvStr := "amount\n\"$1,234.56\"\n(500)\n"
+
+	df, err := LoadFromCSV(ctx, strings.NewReader(csvStr), CSVLoadOptions{
+		Comma:           ',',
+		DictateDataType: map[string]interface{}{"amount": float64(0)},
+		NumericCleaning: nc,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := dataframe.NewDataFrame(dataframe.NewSeriesFloat64("amount", nil, 1234.56, -500.0))
+	if df.Table() != expected.Table() {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), df.Table())
+	}
+
+	// Values that can't be parsed
+	for _, v := range []string{"(-500)", "abc"} {
+		_, err := LoadFromCSV(ctx, strings.NewReader("amount\n"+v+"\n"), CSVLoadOptions{
+			Comma:           ',',
+			DictateDataType: map[string]interface{}{"amount": int64(0)},
+			NumericCleaning: nc,
+		})
+		if err == nil {
+			t.Errorf("expected error for %s", v)
+		}
+	}
+
+	// Values that can't be parsed are stored as nil up to MaxErrors
+	lenient := *nc
+	lenient.MaxErrors = 2
+
+	csvStr = "amount\n(-500)\nabc\n(500)\n"
+
+	df, err = LoadFromCSV(ctx, strings.NewReader(csvStr), CSVLoadOptions{
+		Comma:           ',',
+		DictateDataType: map[string]interface{}{"amount": int64(0)},
+		NumericCleaning: &lenient,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected = dataframe.NewDataFrame(dataframe.NewSeriesInt64("amount", nil, nil, nil, -500))
+	if df.Table() != expected.Table() {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), df.Table())
+	}
+
+	lenient.MaxErrors = 1
+	_, err = LoadFromCSV(ctx, strings.NewReader(csvStr), CSVLoadOptions{
+		Comma:           ',',
+		DictateDataType: map[string]interface{}{"amount": int64(0)},
+		NumericCleaning: &lenient,
+	})
+	if err == nil {
+		t.Errorf("expected error when MaxErrors is exceeded")
+	}
+}
+
+func TestLoadFromCSVInferBool(t *testing.T) {
+	ctx := context.Background()
+
+	csvStr := "flag,dictated\ntrue,1\n,false\nFALSE,TRUE\n"
+
+	df, err := LoadFromCSV(ctx, strings.NewReader(csvStr), CSVLoadOptions{
+		Comma:           ',',
+		DictateDataType: map[string]interface{}{"dictated": false},
+		InferDataTypes:  true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := dataframe.NewDataFrame(
+		dataframe.NewSeriesBool("flag", nil, true, nil, false),
+		dataframe.NewSeriesInt64("dictated", nil, 1, 0, 1),
+	)
+
+	eq, err := df.IsEqual(expected)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !eq {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), df.Table())
+	}
+}
+
+func TestLoadFromCSVInferOverflow(t *testing.T) {
+	ctx := context.Background()
+
+	csvStr := "id,big,mixed\n9007199254740993,1,1\n2,18446744073709551616,2.5\n"
+
+	df, err := LoadFromCSV(ctx, strings.NewReader(csvStr), CSVLoadOptions{
+		Comma:          ',',
+		InferDataTypes: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Integers that lose precision as a float64 remain exact
+	expected := dataframe.NewDataFrame(
+		dataframe.NewSeriesInt64("id", nil, 9007199254740993, 2),
+		dataframe.NewSeriesFloat64("big", nil, 1.0, 18446744073709551616.0),
+		dataframe.NewSeriesFloat64("mixed", nil, 1.0, 2.5),
+	)
+
+	eq, err := df.IsEqual(expected)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !eq {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), df.Table())
+	}
+
+	df, err = LoadFromCSV(ctx, strings.NewReader(csvStr), CSVLoadOptions{
+		Comma:            ',',
+		InferDataTypes:   true,
+		OverflowToString: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected = dataframe.NewDataFrame(
+		dataframe.NewSeriesInt64("id", nil, 9007199254740993, 2),
+		dataframe.NewSeriesString("big", nil, "1", "18446744073709551616"),
+		dataframe.NewSeriesFloat64("mixed", nil, 1.0, 2.5),
+	)
+
+	eq, err = df.IsEqual(expected)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !eq {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), df.Table())
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"sort"
+	"strconv"
 	"time"
 
 	dataframe "github.com/rocketlaunchr/dataframe-go"
@@ -28,12 +30,47 @@ type JSONLoadOptions struct {
 
 	// ErrorOnUnknownFields will generate an error if an unknown field is encountered after the first row.
 	ErrorOnUnknownFields bool
+
+	// OverflowToString will infer a field as a string instead of a float64 when it contains integers
+	// that don't fit in an int64. This preserves every digit of large ids.
+	// It is only relevant for data in records orientation.
+	OverflowToString bool
 }
 
 // LoadFromJSON will load data from a jsonl file.
 // The first row determines which fields will be imported for subsequent rows.
+//
+// If the data is instead an array of objects (records orientation), every field found in any object is imported.
+// The data type of each field that is not dictated by DictateDataType is inferred from the first non-null value:
+// integers are stored as int64, other numbers as float64, bools in a SeriesBool and strings formatted using
+// RFC3339 as time.Time. All other fields are stored as strings. Missing and null values are stored as nil.
+// A numeric field is promoted to float64 if any of its values is not an integer or is an integer that doesn't
+// fit in an int64 (see OverflowToString). Integers that lose precision as a float64 remain exact.
 func LoadFromJSON(ctx context.Context, r io.ReadSeeker, options ...JSONLoadOptions) (*dataframe.DataFrame, error) {
 
+	// Check if data is in records orientation
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	t, err := dec.Token()
+	if err != nil {
+		if err == io.EOF {
+			return nil, dataframe.ErrNoRows
+		}
+		return nil, err
+	}
+
+	if delim, ok := t.(json.Delim); ok && delim == '[' {
+		var opts JSONLoadOptions
+		if len(options) > 0 {
+			opts = options[0]
+		}
+		return loadJSONRecords(ctx, dec, opts)
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
 	var init *dataframe.SeriesInit
 
 	if len(options) > 0 {
@@ -51,7 +88,9 @@ func LoadFromJSON(ctx context.Context, r io.ReadSeeker, options ...JSONLoadOptio
 				t, err := dec.Token()
 				if err != nil {
 					if err == io.EOF {
-						r.Seek(0, io.SeekStart)
+						if _, err := r.Seek(0, io.SeekStart); err != nil {
+							return nil, err
+						}
 						break
 					}
 					return nil, err
@@ -77,7 +116,7 @@ func LoadFromJSON(ctx context.Context, r io.ReadSeeker, options ...JSONLoadOptio
 	var row int
 	var df *dataframe.DataFrame
 
-	dec := json.NewDecoder(r)
+	dec = json.NewDecoder(r)
 	dec.UseNumber()
 	for {
 		if err := ctx.Err(); err != nil {
@@ -265,3 +304,173 @@ func LoadFromJSON(ctx context.Context, r io.ReadSeeker, options ...JSONLoadOptio
 
 	return df, nil
 }
+
+// loadJSONRecords loads an array of objects. The opening delimiter must already be consumed from dec.
+func loadJSONRecords(ctx context.Context, dec *json.Decoder, opts JSONLoadOptions) (*dataframe.DataFrame, error) {
+
+	var (
+		names    []string                   // In order of first appearance
+		types    = map[string]interface{}{} // Data type of each field
+		inferred = map[string]bool{}        // Fields whose data type was not dictated
+		overflow = map[string]bool{}        // Fields containing integers that don't fit in an int64
+		records  = []map[string]interface{}{}
+	)
+
+	for dec.More() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		var raw map[string]interface{}
+		err := dec.Decode(&raw)
+		if err != nil {
+			return nil, err
+		}
+
+		vals := parseObject(raw, "")
+
+		keys := make([]string, 0, len(vals))
+		for name := range vals {
+			keys = append(keys, name)
+		}
+		sort.Strings(keys)
+
+		for _, name := range keys {
+			typ, exists := types[name]
+			if !exists {
+				names = append(names, name)
+			}
+
+			if typ != nil {
+				// Promote integer fields to float64 if required
+				if v, ok := vals[name].(json.Number); ok && inferred[name] {
+					if _, isInt := typ.(int64); isInt {
+						if _, err := v.Int64(); err != nil {
+							types[name] = float64(0)
+						}
+					}
+					if isOverflow(v) {
+						overflow[name] = true
+					}
+				}
+				continue
+			}
+
+			// Determine data type from first non-null value
+			if dtyp, dictated := opts.DictateDataType[name]; dictated {
+				types[name] = dtyp
+				continue
+			}
+			inferred[name] = true
+
+			switch v := vals[name].(type) {
+			case nil:
+				types[name] = nil
+			case json.Number:
+				if _, err := v.Int64(); err == nil {
+					types[name] = int64(0)
+				} else {
+					types[name] = float64(0)
+					overflow[name] = isOverflow(v)
+				}
+			case bool:
+				types[name] = false
+			case string:
+				if _, err := time.Parse(time.RFC3339, v); err == nil {
+					types[name] = time.Time{}
+				} else {
+					types[name] = ""
+				}
+			default:
+				types[name] = ""
+			}
+		}
+
+		records = append(records, vals)
+	}
+
+	// Consume closing delimiter
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, dataframe.ErrNoRows
+	}
+
+	if opts.OverflowToString {
+		for name := range overflow {
+			if _, isFloat := types[name].(float64); isFloat {
+				types[name] = ""
+			}
+		}
+	}
+
+	init := &dataframe.SeriesInit{Capacity: len(records)}
+
+	seriess := []dataframe.Series{}
+	for _, name := range names {
+		switch T := types[name].(type) {
+		case float64:
+			seriess = append(seriess, dataframe.NewSeriesFloat64(name, init))
+		case bool:
+			if inferred[name] {
+				seriess = append(seriess, dataframe.NewSeriesBool(name, init))
+			} else {
+				seriess = append(seriess, dataframe.NewSeriesInt64(name, init))
+			}
+		case int64:
+			seriess = append(seriess, dataframe.NewSeriesInt64(name, init))
+		case time.Time:
+			seriess = append(seriess, dataframe.NewSeriesTime(name, init))
+		case Converter:
+			seriess = append(seriess, dataframe.NewSeriesGeneric(name, T.ConcreteType, init))
+		case nil, string:
+			seriess = append(seriess, dataframe.NewSeriesString(name, init))
+			types[name] = ""
+		default:
+			seriess = append(seriess, dataframe.NewSeriesGeneric(name, T, init))
+		}
+	}
+
+	df := dataframe.NewDataFrame(seriess...)
+
+	for idx, vals := range records {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		insertVals := map[string]interface{}{}
+		for name, val := range vals {
+			if val == nil {
+				continue
+			}
+
+			if _, isBool := types[name].(bool); isBool && inferred[name] {
+				b, ok := val.(bool)
+				if !ok {
+					return nil, fmt.Errorf("can't force %T to bool. row: %d field: %s", val, idx, name)
+				}
+				insertVals[name] = b
+				continue
+			}
+
+			err := dictateForce(idx+1, insertVals, name, types[name], val)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		df.Append(make([]interface{}, len(df.Series))...)
+		df.UpdateRow(idx, insertVals)
+	}
+
+	return df, nil
+}
+
+// isOverflow returns true if v is an integer that doesn't fit in an int64.
+func isOverflow(v json.Number) bool {
+	_, err := strconv.ParseInt(v.String(), 10, 64)
+	nErr, ok := err.(*strconv.NumError)
+	return ok && nErr.Err == strconv.ErrRange
+}
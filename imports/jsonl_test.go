@@ -0,0 +1,97 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package imports
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	dataframe "github.com/rocketlaunchr/dataframe-go"
+	"github.com/rocketlaunchr/dataframe-go/exports"
+)
+
+func TestJSONRecordsRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	ts := time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// Fields are loaded in alphabetical order
+	df := dataframe.NewDataFrame(
+		dataframe.NewSeriesBool("b", nil, true, nil, false),
+		dataframe.NewSeriesFloat64("f", nil, 1.5, nil, -2.25),
+		dataframe.NewSeriesInt64("i", nil, nil, 2, -3),
+		dataframe.NewSeriesString("s", nil, "a", "", nil),
+		dataframe.NewSeriesTime("t", nil, ts, nil, ts.Add(time.Hour)),
+	)
+
+	var buf bytes.Buffer
+	if err := exports.ExportToJSON(ctx, &buf, df, exports.JSONExportOptions{Records: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	loaded, err := LoadFromJSON(ctx, bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	eq, err := loaded.IsEqual(df)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !eq {
+		t.Errorf("wrong val: expected: %v actual: %v", df.Table(), loaded.Table())
+	}
+
+	// Dictated bools are stored as int64
+	loaded, err = LoadFromJSON(ctx, bytes.NewReader(buf.Bytes()), JSONLoadOptions{DictateDataType: map[string]interface{}{"b": false}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := dataframe.NewSeriesInt64("b", nil, 1, nil, 0)
+	if s := loaded.Series[0]; fmt.Sprint(s) != fmt.Sprint(expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, s)
+	}
+
+	// Mixed types
+	_, err = LoadFromJSON(ctx, bytes.NewReader([]byte(`[{"b": true}, {"b": 1}]`)))
+	if err == nil {
+		t.Errorf("expected error for non-bool value")
+	}
+}
+
+func TestLoadFromJSONOverflow(t *testing.T) {
+	ctx := context.Background()
+
+	jsonStr := `[{"id": 9007199254740993, "big": 1, "mixed": 1}, {"id": 2, "big": 18446744073709551616, "mixed": 2.5}]`
+
+	df, err := LoadFromJSON(ctx, strings.NewReader(jsonStr))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Integers that lose precision as a float64 remain exact
+	expected := dataframe.NewDataFrame(
+		dataframe.NewSeriesFloat64("big", nil, 1.0, 18446744073709551616.0),
+		dataframe.NewSeriesInt64("id", nil, 9007199254740993, 2),
+		dataframe.NewSeriesFloat64("mixed", nil, 1.0, 2.5),
+	)
+
+	if eq, _ := df.IsEqual(expected); !eq {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), df.Table())
+	}
+
+	df, err = LoadFromJSON(ctx, strings.NewReader(jsonStr), JSONLoadOptions{OverflowToString: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedBig := dataframe.NewSeriesString("big", nil, "1", "18446744073709551616")
+	if s := df.Series[0]; fmt.Sprint(s) != fmt.Sprint(expectedBig) {
+		t.Errorf("wrong val: expected: %v actual: %v", expectedBig, s)
+	}
+}
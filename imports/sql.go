@@ -41,9 +41,18 @@ type SQLLoadOptions struct {
 
 	// Database is used to set the Database.
 	Database Database
+
+	// OverflowToString will store the values of an integer column as strings instead of float64 when it
+	// contains integers that don't fit in an int64 (eg. BIGINT UNSIGNED). This preserves every digit of large ids.
+	OverflowToString bool
 }
 
 // LoadFromSQL will load data from a sql database.
+// Rows are streamed from the database and NULL values are stored as nil.
+// The data type of each series is determined by the column's database type
+// (eg. INTEGER => int64, REAL => float64, TEXT => string) unless dictated by DictateDataType.
+// If an integer column contains a value that doesn't fit in an int64, the series is promoted
+// to a SeriesFloat64 (see OverflowToString) and the values already loaded are converted.
 func LoadFromSQL(ctx context.Context, stmt *sql.Stmt, options *SQLLoadOptions, args ...interface{}) (*dataframe.DataFrame, error) {
 
 	var (
@@ -113,9 +122,9 @@ func LoadFromSQL(ctx context.Context, stmt *sql.Stmt, options *SQLLoadOptions, a
 		switch typ {
 		case "VARCHAR", "TEXT", "NVARCHAR", "MEDIUMTEXT", "LONGTEXT":
 			seriess = append(seriess, dataframe.NewSeriesString(name, init))
-		case "FLOAT", "FLOAT4", "FLOAT8", "DOUBLE", "DECIMAL", "NUMERIC":
+		case "FLOAT", "FLOAT4", "FLOAT8", "DOUBLE", "DOUBLE PRECISION", "REAL", "DECIMAL", "NUMERIC":
 			seriess = append(seriess, dataframe.NewSeriesFloat64(name, init))
-		case "BOOL", "INT", "TINYINT", "INT2", "INT4", "INT8", "MEDIUMINT", "SMALLINT", "BIGINT":
+		case "BOOL", "BOOLEAN", "INT", "INTEGER", "TINYINT", "INT2", "INT4", "INT8", "MEDIUMINT", "SMALLINT", "BIGINT", "UNSIGNED BIGINT":
 			seriess = append(seriess, dataframe.NewSeriesInt64(name, init))
 		case "DATETIME", "TIMESTAMP", "TIMESTAMPTZ":
 			seriess = append(seriess, dataframe.NewSeriesTime(name, init))
@@ -130,6 +139,10 @@ func LoadFromSQL(ctx context.Context, stmt *sql.Stmt, options *SQLLoadOptions, a
 	df = dataframe.NewDataFrame(seriess...)
 
 	for rows.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
 		row++
 
 		rowData := make([]interface{}, totalColumns)
@@ -218,19 +231,45 @@ func LoadFromSQL(ctx context.Context, stmt *sql.Stmt, options *SQLLoadOptions, a
 			switch colType {
 			case "VARCHAR", "TEXT", "NVARCHAR", "MEDIUMTEXT", "LONGTEXT":
 				insertVals[fieldName] = *val
-			case "FLOAT", "DOUBLE", "DECIMAL", "NUMERIC", "FLOAT4", "FLOAT8":
+			case "FLOAT", "DOUBLE", "DOUBLE PRECISION", "REAL", "DECIMAL", "NUMERIC", "FLOAT4", "FLOAT8":
 				f, err := strconv.ParseFloat(*val, 64)
 				if err != nil {
 					return nil, fmt.Errorf("can't force string to float64. row: %d field: %s", row-1, fieldName)
 				}
 				insertVals[fieldName] = f
-			case "INT", "TINYINT", "INT2", "INT4", "INT8", "MEDIUMINT", "SMALLINT", "BIGINT":
+			case "INT", "INTEGER", "TINYINT", "INT2", "INT4", "INT8", "MEDIUMINT", "SMALLINT", "BIGINT", "UNSIGNED BIGINT":
+				switch df.Series[colID].(type) {
+				case *dataframe.SeriesFloat64:
+					// Promoted due to overflow
+					f, err := strconv.ParseFloat(*val, 64)
+					if err != nil {
+						return nil, fmt.Errorf("can't force string to float64. row: %d field: %s", row-1, fieldName)
+					}
+					insertVals[fieldName] = f
+					continue
+				case *dataframe.SeriesString:
+					// Promoted due to overflow
+					insertVals[fieldName] = *val
+					continue
+				}
+
 				n, err := strconv.ParseInt(*val, 10, 64)
 				if err != nil {
+					if nErr, ok := err.(*strconv.NumError); ok && nErr.Err == strconv.ErrRange {
+						toString := options != nil && options.OverflowToString
+						df.Series[colID] = promoteInt64(df.Series[colID], toString)
+						if toString {
+							insertVals[fieldName] = *val
+						} else {
+							f, _ := strconv.ParseFloat(*val, 64)
+							insertVals[fieldName] = f
+						}
+						continue
+					}
 					return nil, fmt.Errorf("can't force string to Int. row: %d field: %s", row-1, fieldName)
 				}
 				insertVals[fieldName] = n
-			case "BOOL":
+			case "BOOL", "BOOLEAN":
 				if *val == "true" || *val == "TRUE" || *val == "1" {
 					insertVals[fieldName] = int64(1)
 				} else if *val == "false" || *val == "FALSE" || *val == "0" {
@@ -288,3 +327,32 @@ func LoadFromSQL(ctx context.Context, stmt *sql.Stmt, options *SQLLoadOptions, a
 
 	return df, nil
 }
+
+// promoteInt64 converts the values of s to float64 (or string if toString is set).
+// It is used when an integer column contains a value that doesn't fit in an int64.
+func promoteInt64(s dataframe.Series, toString bool) dataframe.Series {
+
+	n := s.NRows()
+	init := &dataframe.SeriesInit{Capacity: n}
+
+	var out dataframe.Series
+	if toString {
+		out = dataframe.NewSeriesString(s.Name(), init)
+	} else {
+		out = dataframe.NewSeriesFloat64(s.Name(), init)
+	}
+
+	for row := 0; row < n; row++ {
+		v, ok := s.Value(row).(int64)
+		switch {
+		case !ok:
+			out.Append(nil)
+		case toString:
+			out.Append(strconv.FormatInt(v, 10))
+		default:
+			out.Append(float64(v))
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,112 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package imports
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"testing"
+
+	dataframe "github.com/rocketlaunchr/dataframe-go"
+)
+
+// fakeDriver returns the same rows for every query.
+// Every value is returned as []byte (or nil), similar to MySQL's text protocol.
+type fakeDriver struct {
+	names []string
+	types []string
+	rows  [][]driver.Value
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) { return &fakeConn{d}, nil }
+
+type fakeConn struct{ d *fakeDriver }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) { return &fakeStmt{c.d}, nil }
+func (c *fakeConn) Close() error                              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)                 { return nil, fmt.Errorf("not supported") }
+
+type fakeStmt struct{ d *fakeDriver }
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, fmt.Errorf("not supported")
+}
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) { return &fakeRows{d: s.d}, nil }
+
+type fakeRows struct {
+	d   *fakeDriver
+	row int
+}
+
+func (r *fakeRows) Columns() []string                         { return r.d.names }
+func (r *fakeRows) ColumnTypeDatabaseTypeName(idx int) string { return r.d.types[idx] }
+func (r *fakeRows) Close() error                              { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.row >= len(r.d.rows) {
+		return io.EOF
+	}
+	copy(dest, r.d.rows[r.row])
+	r.row++
+	return nil
+}
+
+func TestLoadFromSQLOverflow(t *testing.T) {
+	ctx := context.Background()
+
+	sql.Register("fake_overflow", &fakeDriver{
+		names: []string{"id", "n"},
+		types: []string{"UNSIGNED BIGINT", "INT"},
+		rows: [][]driver.Value{
+			{[]byte("1"), []byte("10")},
+			{nil, nil},
+			{[]byte("18446744073709551615"), []byte("30")},
+			{[]byte("4"), []byte("40")},
+		},
+	})
+
+	db, err := sql.Open("fake_overflow", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer db.Close()
+
+	stmt, err := db.PrepareContext(ctx, "SELECT id, n FROM test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer stmt.Close()
+
+	df, err := LoadFromSQL(ctx, stmt, &SQLLoadOptions{Database: MySQL})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := dataframe.NewDataFrame(
+		dataframe.NewSeriesFloat64("id", nil, 1.0, nil, 18446744073709551615.0, 4.0),
+		dataframe.NewSeriesInt64("n", nil, 10, nil, 30, 40),
+	)
+
+	if eq, _ := df.IsEqual(expected); !eq {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), df.Table())
+	}
+
+	df, err = LoadFromSQL(ctx, stmt, &SQLLoadOptions{Database: MySQL, OverflowToString: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected = dataframe.NewDataFrame(
+		dataframe.NewSeriesString("id", nil, "1", nil, "18446744073709551615", "4"),
+		dataframe.NewSeriesInt64("n", nil, 10, nil, 30, 40),
+	)
+
+	if eq, _ := df.IsEqual(expected); !eq {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), df.Table())
+	}
+}
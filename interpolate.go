@@ -0,0 +1,211 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// InterpolationMethod determines how Interpolate estimates nil values.
+type InterpolationMethod int
+
+const (
+	// InterpolateLinear joins the surrounding non-nil values with a straight line.
+	InterpolateLinear InterpolationMethod = iota
+
+	// InterpolateNearest uses the closest non-nil value. If both
+	// surrounding values are equally close, the preceding value is used.
+	InterpolateNearest
+
+	// InterpolateSpline fits a natural cubic spline through all the non-nil values.
+	InterpolateSpline
+)
+
+// InterpolateOptions is used to modify the behaviour of Interpolate().
+type InterpolateOptions struct {
+
+	// Don't apply lock to the series.
+	DontLock bool
+
+	// X is the x-axis of each row, which must be strictly increasing
+	// and not contain nil values. It can be a SeriesTime, SeriesFloat64 or SeriesInt64
+	// (or any series containing those types) with the same number of rows as the series.
+	// If not set, the rows are assumed to be uniformly spaced.
+	X Series
+}
+
+// Interpolate replaces nil values in place by estimating them from the non-nil values
+// based on method. Leading and trailing nil values are left untouched.
+//
+// Unlike FillNaN, Interpolate can take the spacing of the rows into account,
+// which is important for irregularly sampled data such as sensor readings.
+//
+// Example:
+//
+//  err := s.Interpolate(dataframe.InterpolateSpline, dataframe.InterpolateOptions{X: timestamps})
+//
+func (s *SeriesFloat64) Interpolate(method InterpolationMethod, options ...InterpolateOptions) error {
+
+	var x Series
+	if len(options) > 0 {
+		x = options[0].X
+	}
+
+	var (
+		xs  []float64
+		err error
+	)
+
+	if x != nil {
+		// Determine x-axis before locking s in case x is s.
+		xs, err = interpolationAxis(x)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	if xs == nil {
+		xs = make([]float64, len(s.Values))
+		for i := range xs {
+			xs[i] = float64(i)
+		}
+	} else if len(xs) != len(s.Values) {
+		return ErrMismatchedRows
+	}
+
+	if s.nilCount == 0 {
+		return nil
+	}
+
+	// Known points
+	var (
+		rows []int
+		kx   []float64
+		ky   []float64
+	)
+
+	for i, v := range s.Values {
+		if !isNaN(v) {
+			rows = append(rows, i)
+			kx = append(kx, xs[i])
+			ky = append(ky, v)
+		}
+	}
+
+	if len(rows) < 2 {
+		return nil
+	}
+
+	var estimate func(j int, x float64) float64
+
+	switch method {
+	case InterpolateLinear:
+		estimate = func(j int, x float64) float64 {
+			return ky[j] + (ky[j+1]-ky[j])*(x-kx[j])/(kx[j+1]-kx[j])
+		}
+	case InterpolateNearest:
+		estimate = func(j int, x float64) float64 {
+			if x-kx[j] <= kx[j+1]-x {
+				return ky[j]
+			}
+			return ky[j+1]
+		}
+	case InterpolateSpline:
+		m := naturalSpline(kx, ky)
+		estimate = func(j int, x float64) float64 {
+			h := kx[j+1] - kx[j]
+			a, b := kx[j+1]-x, x-kx[j]
+			return m[j]*a*a*a/(6*h) + m[j+1]*b*b*b/(6*h) +
+				(ky[j]-m[j]*h*h/6)*a/h + (ky[j+1]-m[j+1]*h*h/6)*b/h
+		}
+	default:
+		return errors.New("unknown interpolation method")
+	}
+
+	for j := 0; j < len(rows)-1; j++ {
+		for i := rows[j] + 1; i < rows[j+1]; i++ {
+			s.Values[i] = estimate(j, xs[i])
+			if !isNaN(s.Values[i]) {
+				// Infinite neighbours can produce NaN
+				s.nilCount--
+			}
+		}
+	}
+
+	return nil
+}
+
+// interpolationAxis converts the values of x to float64.
+func interpolationAxis(x Series) ([]float64, error) {
+
+	n := x.NRows()
+	xs := make([]float64, 0, n)
+
+	var t0 time.Time
+	for row := 0; row < n; row++ {
+		var f float64
+
+		switch v := x.Value(row).(type) {
+		case nil:
+			return nil, fmt.Errorf("x contains nil value at row %d", row)
+		case float64:
+			f = v
+		case int64:
+			f = float64(v)
+		case time.Time:
+			if row == 0 {
+				t0 = v
+			}
+			f = float64(v.Sub(t0))
+		default:
+			return nil, fmt.Errorf("x contains unsupported type %T", v)
+		}
+
+		if row > 0 && !(f > xs[row-1]) {
+			return nil, fmt.Errorf("x must be strictly increasing (row %d)", row)
+		}
+		xs = append(xs, f)
+	}
+
+	return xs, nil
+}
+
+// naturalSpline returns the second derivative of the natural cubic spline
+// passing through each point (x[i], y[i]).
+// See: https://en.wikipedia.org/wiki/Spline_interpolation
+func naturalSpline(x, y []float64) []float64 {
+
+	n := len(x)
+	m := make([]float64, n) // m[0] and m[n-1] are 0 for a natural spline
+
+	if n < 3 {
+		return m
+	}
+
+	// Solve tridiagonal system for m[1:n-1] using the Thomas algorithm
+	c := make([]float64, n) // modified super-diagonal
+	d := make([]float64, n) // modified right-hand side
+
+	for i := 1; i < n-1; i++ {
+		h0, h1 := x[i]-x[i-1], x[i+1]-x[i]
+		r := 6 * ((y[i+1]-y[i])/h1 - (y[i]-y[i-1])/h0)
+		b := 2 * (h0 + h1)
+
+		denom := b - h0*c[i-1]
+		c[i] = h1 / denom
+		d[i] = (r - h0*d[i-1]) / denom
+	}
+
+	for i := n - 2; i >= 1; i-- {
+		m[i] = d[i] - c[i]*m[i+1]
+	}
+
+	return m
+}
@@ -0,0 +1,54 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+import (
+	"sync"
+)
+
+// newIterator returns a function that produces the rows to be visited based on options.
+// Unless DontReadLock is set, l is locked before the row is returned and the caller must
+// call unlock once it has read the values of the row. unlock is a no-op once the iteration is complete.
+func newIterator(l sync.Locker, nRows func() int, options ...ValuesOptions) func() (row *int, remaining int, unlock func()) {
+
+	var row int
+	step := 1
+	var dontReadLock bool
+
+	if len(options) > 0 {
+		row = options[0].InitialRow
+		if options[0].Step != 0 {
+			step = options[0].Step
+		}
+		dontReadLock = options[0].DontReadLock
+	}
+
+	noop := func() {}
+
+	return func() (*int, int, func()) {
+		unlock := noop
+		if !dontReadLock {
+			l.Lock()
+			unlock = l.Unlock
+		}
+
+		n := nRows()
+		if row > n-1 || row < 0 {
+			// Don't iterate further
+			unlock()
+			return nil, 0, noop
+		}
+
+		current := row
+		row = row + step
+
+		var remaining int
+		if step > 0 && row <= n-1 {
+			remaining = (n-1-row)/step + 1
+		} else if step < 0 && row >= 0 {
+			remaining = row/(-step) + 1
+		}
+
+		return &current, remaining, unlock
+	}
+}
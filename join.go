@@ -0,0 +1,352 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// JoinType is the type of join performed by Join().
+type JoinType int
+
+const (
+	// InnerJoin only returns rows that have matching keys in both dataframes.
+	InnerJoin JoinType = 0
+
+	// LeftJoin returns all rows of the left dataframe.
+	LeftJoin JoinType = 1
+
+	// RightJoin returns all rows of the right dataframe.
+	RightJoin JoinType = 2
+
+	// OuterJoin returns all rows of both dataframes.
+	OuterJoin JoinType = 3
+)
+
+// JoinOptions is used to modify the behaviour of Join().
+type JoinOptions struct {
+	// Don't apply read lock to the dataframes.
+	DontLock bool
+
+	// JoinType is the type of join. The default is InnerJoin.
+	JoinType JoinType
+
+	// FloatTolerance, if greater than 0, allows float64 keys to match when the absolute
+	// difference between them is less than or equal to FloatTolerance.
+	//
+	// Keys are bucketized by dividing by FloatTolerance and rounding down. Keys within FloatTolerance
+	// of each other are therefore always in the same or adjacent buckets. Each key of the left dataframe
+	// is compared against the keys of the right dataframe in its own bucket and the 2 neighbouring buckets,
+	// and a match is only accepted after verifying the difference is within FloatTolerance.
+	//
+	// Limitations: matching within a tolerance is not transitive, so a key can match multiple
+	// keys that don't match each other. Each match produces a row. The value of a float64 key
+	// in the joined dataframe is taken from the left dataframe (when available).
+	FloatTolerance float64
+
+	// LeftSuffix is appended to the name of a non-key series of left when right contains a
+	// non-key series with the same name. The default is "_left".
+	LeftSuffix string
+
+	// RightSuffix is appended to the name of a non-key series of right when left contains a
+	// non-key series with the same name. The default is "_right".
+	RightSuffix string
+}
+
+// Join combines the rows of left and right where the series named in on are equal.
+// The series named in on must exist in both dataframes and be of the same type.
+// Nil keys never match.
+//
+// The joined dataframe contains the key series, followed by the remaining series of left
+// and then the remaining series of right. If left and right both contain a remaining series
+// with the same name, both are renamed using LeftSuffix and RightSuffix. Unmatched rows
+// contain nil values in the series of the other dataframe.
+// Rows are ordered by left. Unmatched rows of right (for RightJoin and OuterJoin) are placed last.
+//
+// Example:
+//
+//  joined, err := dataframe.Join(ctx, sales, regions, []string{"region_id"}, dataframe.JoinOptions{JoinType: dataframe.LeftJoin})
+//
+func Join(ctx context.Context, left, right *DataFrame, on []string, options ...JoinOptions) (*DataFrame, error) {
+
+	var opts JoinOptions
+	if len(options) > 0 {
+		opts = options[0]
+	}
+
+	if !opts.DontLock {
+		left.lock.RLock()
+		defer left.lock.RUnlock()
+		if right != left {
+			right.lock.RLock()
+			defer right.lock.RUnlock()
+		}
+	}
+
+	if len(on) == 0 {
+		return nil, errors.New("no join keys provided")
+	}
+
+	if opts.FloatTolerance < 0 {
+		return nil, errors.New("FloatTolerance must not be negative")
+	}
+
+	// Locate key series
+	leftKeys := []Series{}
+	rightKeys := []Series{}
+	isKey := map[string]struct{}{}
+
+	for _, name := range on {
+		lIdx, err := left.NameToColumn(name)
+		if err != nil {
+			return nil, errors.New(err.Error() + ": " + name)
+		}
+		rIdx, err := right.NameToColumn(name)
+		if err != nil {
+			return nil, errors.New(err.Error() + ": " + name)
+		}
+
+		ls, rs := left.Series[lIdx], right.Series[rIdx]
+		if ls.Type() != rs.Type() {
+			return nil, fmt.Errorf("key series must be of the same type: %s", name)
+		}
+
+		leftKeys = append(leftKeys, ls)
+		rightKeys = append(rightKeys, rs)
+		isKey[name] = struct{}{}
+	}
+
+	leftSuffix, rightSuffix := "_left", "_right"
+	if opts.LeftSuffix != "" {
+		leftSuffix = opts.LeftSuffix
+	}
+	if opts.RightSuffix != "" {
+		rightSuffix = opts.RightSuffix
+	}
+
+	// Determine names of remaining series, suffixing names that collide
+	inLeft := map[string]struct{}{}
+	for _, aSeries := range left.Series {
+		inLeft[aSeries.Name()] = struct{}{}
+	}
+
+	collides := map[string]struct{}{}
+	for _, aSeries := range right.Series {
+		name := aSeries.Name()
+		if _, exists := isKey[name]; exists {
+			continue
+		}
+		if _, exists := inLeft[name]; exists {
+			collides[name] = struct{}{}
+		}
+	}
+
+	joinedName := func(name, suffix string) string {
+		if _, exists := collides[name]; exists {
+			return name + suffix
+		}
+		return name
+	}
+
+	names := map[string]struct{}{}
+	for _, name := range on {
+		names[name] = struct{}{}
+	}
+	for _, x := range []struct {
+		df     *DataFrame
+		suffix string
+	}{{left, leftSuffix}, {right, rightSuffix}} {
+		for _, aSeries := range x.df.Series {
+			name := aSeries.Name()
+			if _, exists := isKey[name]; exists {
+				continue
+			}
+			name = joinedName(name, x.suffix)
+			if _, exists := names[name]; exists {
+				return nil, fmt.Errorf("series name must be unique: %s", name)
+			}
+			names[name] = struct{}{}
+		}
+	}
+
+	// Index the right dataframe
+	index := map[string][]int{}
+	for row := 0; row < right.n; row++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		hashes := joinHashes(rightKeys, row, opts.FloatTolerance, false)
+		if len(hashes) == 0 {
+			continue
+		}
+		index[hashes[0]] = append(index[hashes[0]], row)
+	}
+
+	// Match rows
+	var (
+		leftRows     []int
+		rightRows    []int
+		rightMatched = make([]bool, right.n)
+	)
+
+	for lRow := 0; lRow < left.n; lRow++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		var matches []int
+		for _, hash := range joinHashes(leftKeys, lRow, opts.FloatTolerance, true) {
+			for _, rRow := range index[hash] {
+				if joinKeysEqual(leftKeys, rightKeys, lRow, rRow, opts.FloatTolerance) {
+					matches = append(matches, rRow)
+				}
+			}
+		}
+		sort.Ints(matches) // Neighbouring buckets are probed separately
+
+		for _, rRow := range matches {
+			rightMatched[rRow] = true
+			leftRows = append(leftRows, lRow)
+			rightRows = append(rightRows, rRow)
+		}
+
+		if len(matches) == 0 && (opts.JoinType == LeftJoin || opts.JoinType == OuterJoin) {
+			leftRows = append(leftRows, lRow)
+			rightRows = append(rightRows, -1)
+		}
+	}
+
+	if opts.JoinType == RightJoin || opts.JoinType == OuterJoin {
+		for rRow, matched := range rightMatched {
+			if !matched {
+				leftRows = append(leftRows, -1)
+				rightRows = append(rightRows, rRow)
+			}
+		}
+	}
+
+	// Generate the joined dataframe
+	seriess := []Series{}
+
+	for i := range on {
+		s, err := subset(ctx, leftKeys[i], leftRows)
+		if err != nil {
+			return nil, err
+		}
+
+		// Fill keys of unmatched right rows
+		for row, lRow := range leftRows {
+			if lRow == -1 {
+				s.Update(row, rightKeys[i].Value(rightRows[row]))
+			}
+		}
+
+		seriess = append(seriess, s)
+	}
+
+	for _, x := range []struct {
+		df     *DataFrame
+		rows   []int
+		suffix string
+	}{{left, leftRows, leftSuffix}, {right, rightRows, rightSuffix}} {
+		for _, aSeries := range x.df.Series {
+			name := aSeries.Name()
+			if _, exists := isKey[name]; exists {
+				continue
+			}
+
+			s, err := subset(ctx, aSeries, x.rows)
+			if err != nil {
+				return nil, err
+			}
+			s.Rename(joinedName(name, x.suffix))
+			seriess = append(seriess, s)
+		}
+	}
+
+	return NewDataFrame(seriess...), nil
+}
+
+// joinHashes returns the hashes of the keys at row. When probe is set, the neighbouring
+// buckets of float64 keys are also returned (if tolerance is greater than 0).
+// No hashes are returned if any key is nil.
+func joinHashes(keys []Series, row int, tolerance float64, probe bool) []string {
+
+	hashes := []string{""}
+
+	for i, s := range keys {
+		val := s.Value(row)
+		if val == nil {
+			return nil
+		}
+
+		var parts []string
+
+		switch v := val.(type) {
+		case float64:
+			if tolerance > 0 {
+				bucket := int64(math.Floor(v / tolerance))
+				if probe {
+					parts = []string{
+						strconv.FormatInt(bucket-1, 10),
+						strconv.FormatInt(bucket, 10),
+						strconv.FormatInt(bucket+1, 10),
+					}
+				} else {
+					parts = []string{strconv.FormatInt(bucket, 10)}
+				}
+			} else {
+				if v == 0 {
+					v = 0 // Treat -0 and +0 the same
+				}
+				parts = []string{strconv.FormatFloat(v, 'g', -1, 64)}
+			}
+		case time.Time:
+			parts = []string{strconv.FormatInt(v.UnixNano(), 10)}
+		default:
+			parts = []string{fmt.Sprintf("%v", v)}
+		}
+
+		newHashes := make([]string, 0, len(hashes)*len(parts))
+		for _, h := range hashes {
+			for _, p := range parts {
+				if i == 0 {
+					newHashes = append(newHashes, p)
+				} else {
+					newHashes = append(newHashes, strings.Join([]string{h, p}, "\x00"))
+				}
+			}
+		}
+		hashes = newHashes
+	}
+
+	return hashes
+}
+
+// joinKeysEqual verifies that the keys at lRow of left are equal to the keys at rRow of right.
+func joinKeysEqual(leftKeys, rightKeys []Series, lRow, rRow int, tolerance float64) bool {
+	for i := range leftKeys {
+		lVal := leftKeys[i].Value(lRow)
+		rVal := rightKeys[i].Value(rRow)
+
+		if lf, ok := lVal.(float64); ok && tolerance > 0 {
+			if math.Abs(lf-rVal.(float64)) > tolerance {
+				return false
+			}
+			continue
+		}
+
+		if !leftKeys[i].IsEqualFunc(lVal, rVal) {
+			return false
+		}
+	}
+	return true
+}
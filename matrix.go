@@ -0,0 +1,108 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// MatrixOptions modifies the behavior of ToMatrix.
+type MatrixOptions struct {
+
+	// DontLock can be set to true if the DataFrame should not be locked.
+	DontLock bool
+
+	// AllowNaN will copy NaN values into the matrix instead of returning an error.
+	AllowNaN bool
+}
+
+// ToMatrix returns a gonum matrix containing the values of the selected float64 series.
+// Each series becomes a column of the matrix. If cols is nil, all series are selected.
+// An error is returned if a selected series is not a SeriesFloat64 or contains NaN values
+// (unless AllowNaN is set).
+//
+// Example:
+//
+//  m, err := df.ToMatrix([]string{"x1", "x2"})
+//  var qr mat.QR
+//  qr.Factorize(m)
+//
+func (df *DataFrame) ToMatrix(cols []string, options ...MatrixOptions) (mat.Matrix, error) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	var allowNaN bool
+	if len(options) > 0 {
+		allowNaN = options[0].AllowNaN
+	}
+
+	if cols == nil {
+		cols = df.Names()
+	}
+
+	if len(cols) == 0 || df.n == 0 {
+		return nil, ErrNoRows
+	}
+
+	data := make([]float64, df.n*len(cols))
+
+	for j, name := range cols {
+		idx, err := df.NameToColumn(name)
+		if err != nil {
+			return nil, err
+		}
+
+		s, ok := df.Series[idx].(*SeriesFloat64)
+		if !ok {
+			return nil, fmt.Errorf("series %s is not a SeriesFloat64", name)
+		}
+
+		for i, v := range s.Values {
+			if isNaN(v) && !allowNaN {
+				return nil, fmt.Errorf("series %s contains NaN at row %d", name, i)
+			}
+			data[i*len(cols)+j] = v
+		}
+	}
+
+	return mat.NewDense(df.n, len(cols), data), nil
+}
+
+// NewDataFrameFromMatrix creates a new DataFrame with a SeriesFloat64 for each column of m.
+// names sets the name of each series. If names is not provided, the series are named by their column index.
+func NewDataFrameFromMatrix(m mat.Matrix, names ...string) *DataFrame {
+
+	r, c := m.Dims()
+
+	if len(names) > 0 && len(names) != c {
+		panic("number of names must match number of columns")
+	}
+
+	seriess := []Series{}
+	for j := 0; j < c; j++ {
+		var name string
+		if len(names) > 0 {
+			name = names[j]
+		} else {
+			name = fmt.Sprintf("%d", j)
+		}
+
+		s := NewSeriesFloat64(name, &SeriesInit{Size: r})
+		s.nilCount = 0
+		for i := 0; i < r; i++ {
+			v := m.At(i, j)
+			if isNaN(v) {
+				s.nilCount++
+			}
+			s.Values[i] = v
+		}
+
+		seriess = append(seriess, s)
+	}
+
+	return NewDataFrame(seriess...)
+}
@@ -0,0 +1,175 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// MeltOptions is used to modify the behaviour of Melt().
+type MeltOptions struct {
+	// Don't apply read lock to the dataframe.
+	DontLock bool
+
+	// VarName is the name of the series containing the names of the melted series.
+	// The default is "variable".
+	VarName string
+
+	// ValueName is the name of the series containing the values of the melted series.
+	// The default is "value".
+	ValueName string
+}
+
+// Melt unpivots df from wide to long format. For each row of df, a row is generated for each of the
+// series named in valueVars. If valueVars is empty, all series not named in idVars are melted.
+// The series named in idVars are duplicated for each generated row.
+//
+// The variable series is a SeriesString containing the name of the melted series.
+// The data type of the value series is determined by promoting the data types of the melted series:
+//
+// 1. If all melted series are of the same data type (float64, int64, string, bool or time), that data type is used.
+//
+// 2. If all melted series are float64 or int64, float64 is used.
+//
+// 3. Otherwise string is used.
+//
+// nil values remain nil.
+//
+// Example:
+//
+//  long, err := dataframe.Melt(ctx, df, []string{"id"}, []string{"q1", "q2", "q3"})
+//
+func Melt(ctx context.Context, df *DataFrame, idVars []string, valueVars []string, options ...MeltOptions) (*DataFrame, error) {
+
+	var opts MeltOptions
+	if len(options) > 0 {
+		opts = options[0]
+	}
+
+	if !opts.DontLock {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	varName, valueName := "variable", "value"
+	if opts.VarName != "" {
+		varName = opts.VarName
+	}
+	if opts.ValueName != "" {
+		valueName = opts.ValueName
+	}
+	if varName == valueName {
+		return nil, fmt.Errorf("variable and value series can't have the same name: %s", varName)
+	}
+
+	isID := map[string]struct{}{}
+	idSeries := []Series{}
+	for _, name := range idVars {
+		idx, err := df.NameToColumn(name)
+		if err != nil {
+			return nil, errors.New(err.Error() + ": " + name)
+		}
+		isID[name] = struct{}{}
+		idSeries = append(idSeries, df.Series[idx])
+	}
+
+	if len(valueVars) == 0 {
+		for _, aSeries := range df.Series {
+			if _, exists := isID[aSeries.Name()]; !exists {
+				valueVars = append(valueVars, aSeries.Name())
+			}
+		}
+	}
+
+	if len(valueVars) == 0 {
+		return nil, errors.New("no series to melt")
+	}
+
+	for _, name := range []string{varName, valueName} {
+		if _, exists := isID[name]; exists {
+			return nil, fmt.Errorf("series name already exists: %s", name)
+		}
+	}
+
+	// Determine data type of value series
+	valueSeries := []Series{}
+	types := map[string]struct{}{}
+	for _, name := range valueVars {
+		idx, err := df.NameToColumn(name)
+		if err != nil {
+			return nil, errors.New(err.Error() + ": " + name)
+		}
+		valueSeries = append(valueSeries, df.Series[idx])
+		types[df.Series[idx].Type()] = struct{}{}
+	}
+
+	targetType := "string"
+	if len(types) == 1 {
+		for typ := range types {
+			switch typ {
+			case "float64", "int64", "string", "bool", "time":
+				targetType = typ
+			}
+		}
+	} else {
+		_, hasFloat := types["float64"]
+		_, hasInt := types["int64"]
+		if len(types) == 2 && hasFloat && hasInt {
+			targetType = "float64"
+		}
+	}
+
+	var zero interface{}
+	switch targetType {
+	case "float64":
+		zero = float64(0)
+	case "int64":
+		zero = int64(0)
+	case "bool":
+		zero = false
+	case "time":
+		zero = time.Time{}
+	default:
+		zero = ""
+	}
+
+	// Generate melted dataframe
+	nRows := df.n * len(valueVars)
+	init := &SeriesInit{Capacity: nRows}
+
+	variables := NewSeriesString(varName, init)
+	values := newSeriesFromType(valueName, zero, init)
+	rows := make([]int, 0, nRows)
+
+	for i, aSeries := range valueSeries {
+		for row := 0; row < df.n; row++ {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			val, err := castValue(aSeries.Value(row), targetType)
+			if err != nil {
+				return nil, err
+			}
+
+			rows = append(rows, row)
+			variables.Append(valueVars[i])
+			values.Append(val)
+		}
+	}
+
+	seriess := []Series{}
+	for _, aSeries := range idSeries {
+		s, err := subset(ctx, aSeries, rows)
+		if err != nil {
+			return nil, err
+		}
+		seriess = append(seriess, s)
+	}
+	seriess = append(seriess, variables, values)
+
+	return NewDataFrame(seriess...), nil
+}
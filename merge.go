@@ -0,0 +1,94 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+import (
+	"errors"
+)
+
+// MergeSortedOptions is used to modify the behaviour of MergeSorted().
+type MergeSortedOptions struct {
+	// Don't apply read lock to the series.
+	DontLock bool
+
+	// SortDesc must be set if the series are sorted in descending order.
+	SortDesc bool
+
+	// Validate will check that both series are sorted before merging them.
+	// Otherwise they are assumed to be sorted.
+	Validate bool
+}
+
+// MergeSorted merges 2 sorted series into a new sorted series in O(n+m) time.
+// Both series must be sorted in the same manner as Sort does, i.e. nil values first
+// when sorted in ascending order and last when sorted in descending order.
+// When values are equal, values from a are placed before values from b.
+// The returned series takes the name of a.
+//
+// Example:
+//
+//  merged, err := dataframe.MergeSorted(a, b, dataframe.MergeSortedOptions{Validate: true})
+//
+func MergeSorted(a, b *SeriesFloat64, options ...MergeSortedOptions) (*SeriesFloat64, error) {
+
+	var opts MergeSortedOptions
+	if len(options) > 0 {
+		opts = options[0]
+	}
+
+	if !opts.DontLock {
+		a.lock.RLock()
+		defer a.lock.RUnlock()
+		if b != a {
+			b.lock.RLock()
+			defer b.lock.RUnlock()
+		}
+	}
+
+	// before returns true if x must be placed before y.
+	before := func(x, y float64) bool {
+		if isNaN(x) || isNaN(y) {
+			if opts.SortDesc {
+				return !isNaN(x) && isNaN(y)
+			}
+			return isNaN(x) && !isNaN(y)
+		}
+		if opts.SortDesc {
+			return x > y
+		}
+		return x < y
+	}
+
+	if opts.Validate {
+		for _, vals := range [][]float64{a.Values, b.Values} {
+			for i := 1; i < len(vals); i++ {
+				if before(vals[i], vals[i-1]) {
+					return nil, errors.New("series is not sorted")
+				}
+			}
+		}
+	}
+
+	ns := NewSeriesFloat64(a.name, &SeriesInit{Capacity: len(a.Values) + len(b.Values)})
+
+	var i, j int
+	for i < len(a.Values) && j < len(b.Values) {
+		if before(b.Values[j], a.Values[i]) {
+			ns.Values = append(ns.Values, b.Values[j])
+			j++
+		} else {
+			ns.Values = append(ns.Values, a.Values[i])
+			i++
+		}
+	}
+	ns.Values = append(ns.Values, a.Values[i:]...)
+	ns.Values = append(ns.Values, b.Values[j:]...)
+
+	for _, v := range ns.Values {
+		if isNaN(v) {
+			ns.nilCount++
+		}
+	}
+
+	return ns, nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package parquet
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/xitongsys/parquet-go-source/writerfile"
+	"github.com/xitongsys/parquet-go/writer"
+
+	dataframe "github.com/rocketlaunchr/dataframe-go"
+)
+
+// ExportOptions contains options for ExportToParquet function.
+type ExportOptions struct {
+
+	// Range is used to export a subset of rows from the dataframe.
+	Range dataframe.Range
+
+	// Parallel is the number of goroutines used to write the data.
+	// If not set, 4 is used.
+	Parallel int
+}
+
+// ExportToParquet exports a dataframe to a parquet file.
+// Each series is written as an optional column based on its type:
+//
+//  float64 => DOUBLE
+//  int64 => INT64
+//  bool => BOOLEAN
+//  time.Time => INT64 (TIMESTAMP_MILLIS)
+//  string and others => BYTE_ARRAY (UTF8)
+//
+// Nil values are written as null. An error is returned if a series name contains a comma,
+// an equals sign, a tab or leading/trailing whitespace.
+func ExportToParquet(ctx context.Context, w io.Writer, df *dataframe.DataFrame, options ...ExportOptions) error {
+
+	df.Lock()
+	defer df.Unlock()
+
+	var r dataframe.Range
+	np := int64(4)
+
+	if len(options) > 0 {
+		r = options[0].Range
+		if options[0].Parallel > 0 {
+			np = int64(options[0].Parallel)
+		}
+	}
+
+	// Determine schema
+	md := []string{}
+	asString := map[int]bool{} // Series exported using ValueString
+	for idx, aSeries := range df.Series {
+		name := aSeries.Name()
+		if strings.ContainsAny(name, ",=\t") || strings.TrimSpace(name) != name {
+			// The schema is defined using tags, which can't be escaped.
+			return fmt.Errorf("series name %q can't be exported to parquet", name)
+		}
+
+		var typ string
+
+		switch aSeries.(type) {
+		case *dataframe.SeriesFloat64:
+			typ = "type=DOUBLE"
+		case *dataframe.SeriesInt64:
+			typ = "type=INT64"
+		case *dataframe.SeriesBool:
+			typ = "type=BOOLEAN"
+		case *dataframe.SeriesTime:
+			typ = "type=INT64, convertedtype=TIMESTAMP_MILLIS"
+		default:
+			typ = "type=BYTE_ARRAY, convertedtype=UTF8"
+			asString[idx] = true
+		}
+
+		md = append(md, fmt.Sprintf("name=%s, %s, repetitiontype=OPTIONAL", name, typ))
+	}
+
+	pw, err := writer.NewCSVWriter(md, writerfile.NewWriterFile(w), np)
+	if err != nil {
+		return err
+	}
+
+	nRows := df.NRows(dataframe.DontLock)
+
+	if nRows > 0 {
+
+		s, e, err := r.Limits(nRows)
+		if err != nil {
+			return err
+		}
+
+		for row := s; row <= e; row++ {
+
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			rec := make([]interface{}, 0, len(df.Series))
+			for idx, aSeries := range df.Series {
+
+				val := aSeries.Value(row, dataframe.DontLock)
+
+				switch v := val.(type) {
+				case nil:
+					rec = append(rec, nil)
+				default:
+					if asString[idx] {
+						rec = append(rec, aSeries.ValueString(row, dataframe.DontLock))
+					} else if t, ok := v.(time.Time); ok {
+						rec = append(rec, t.UnixNano()/int64(time.Millisecond))
+					} else {
+						rec = append(rec, v)
+					}
+				}
+			}
+
+			if err := pw.Write(rec); err != nil {
+				return err
+			}
+		}
+	}
+
+	return pw.WriteStop()
+}
@@ -0,0 +1,232 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package parquet
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/source"
+	"github.com/xitongsys/parquet-go/types"
+
+	dataframe "github.com/rocketlaunchr/dataframe-go"
+)
+
+// LoadOptions is likely to change.
+type LoadOptions struct {
+
+	// Parallel is the number of goroutines used to read the data.
+	// If not set, 4 is used.
+	Parallel int
+}
+
+// LoadFromParquet will load data from a parquet file.
+// Each (leaf) column is loaded into a series based on its type:
+//
+//  BOOLEAN => bool
+//  INT32, INT64 => int64
+//  INT32 (DATE), INT64 (TIMESTAMP_MILLIS, TIMESTAMP_MICROS), INT96 => time.Time
+//  FLOAT, DOUBLE => float64
+//  BYTE_ARRAY, FIXED_LEN_BYTE_ARRAY => string
+//
+// Null values are stored as nil. Columns nested inside groups are named using their full path (eg. "address.city").
+// An error is returned if the schema contains repeated fields (including lists and maps).
+func LoadFromParquet(ctx context.Context, r io.ReadSeeker, options ...LoadOptions) (*dataframe.DataFrame, error) {
+
+	np := int64(4)
+	if len(options) > 0 && options[0].Parallel > 0 {
+		np = int64(options[0].Parallel)
+	}
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err := reader.NewParquetColumnReader(&parquetFile{bytes.NewReader(data), data}, np)
+	if err != nil {
+		return nil, err
+	}
+	defer pr.ReadStop()
+
+	nRows := pr.GetNumRows()
+	if nRows == 0 {
+		return nil, dataframe.ErrNoRows
+	}
+
+	leaves, err := leafColumns(pr.Footer.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	init := &dataframe.SeriesInit{Capacity: int(nRows)}
+
+	seriess := []dataframe.Series{}
+
+	for col, leaf := range leaves {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		vals, _, _, err := pr.ReadColumnByIndex(int64(col), nRows)
+		if err != nil {
+			return nil, err
+		}
+
+		if int64(len(vals)) != nRows {
+			return nil, fmt.Errorf("column %s contains %d values but there are %d rows", leaf.path, len(vals), nRows)
+		}
+
+		var (
+			s       dataframe.Series
+			convert func(v interface{}) interface{}
+		)
+
+		elem, name := leaf.elem, leaf.path
+
+		switch elem.GetType() {
+		case parquet.Type_BOOLEAN:
+			s = dataframe.NewSeriesBool(name, init)
+			convert = func(v interface{}) interface{} { return v }
+		case parquet.Type_INT32:
+			if elem.IsSetConvertedType() && elem.GetConvertedType() == parquet.ConvertedType_DATE {
+				s = dataframe.NewSeriesTime(name, init)
+				convert = func(v interface{}) interface{} {
+					return time.Unix(int64(v.(int32))*24*60*60, 0).UTC()
+				}
+			} else {
+				s = dataframe.NewSeriesInt64(name, init)
+				convert = func(v interface{}) interface{} { return int64(v.(int32)) }
+			}
+		case parquet.Type_INT64:
+			switch {
+			case elem.IsSetConvertedType() && elem.GetConvertedType() == parquet.ConvertedType_TIMESTAMP_MILLIS:
+				s = dataframe.NewSeriesTime(name, init)
+				convert = func(v interface{}) interface{} {
+					return time.Unix(0, v.(int64)*int64(time.Millisecond)).UTC()
+				}
+			case elem.IsSetConvertedType() && elem.GetConvertedType() == parquet.ConvertedType_TIMESTAMP_MICROS:
+				s = dataframe.NewSeriesTime(name, init)
+				convert = func(v interface{}) interface{} {
+					return time.Unix(0, v.(int64)*int64(time.Microsecond)).UTC()
+				}
+			default:
+				s = dataframe.NewSeriesInt64(name, init)
+				convert = func(v interface{}) interface{} { return v }
+			}
+		case parquet.Type_INT96:
+			s = dataframe.NewSeriesTime(name, init)
+			convert = func(v interface{}) interface{} { return types.INT96ToTime(v.(string)).UTC() }
+		case parquet.Type_FLOAT:
+			s = dataframe.NewSeriesFloat64(name, init)
+			convert = func(v interface{}) interface{} { return float64(v.(float32)) }
+		case parquet.Type_DOUBLE:
+			s = dataframe.NewSeriesFloat64(name, init)
+			convert = func(v interface{}) interface{} { return v }
+		default:
+			s = dataframe.NewSeriesString(name, init)
+			convert = func(v interface{}) interface{} { return v }
+		}
+
+		for _, v := range vals {
+			if v == nil {
+				s.Append(nil, dataframe.DontLock)
+			} else {
+				s.Append(convert(v), dataframe.DontLock)
+			}
+		}
+
+		seriess = append(seriess, s)
+	}
+
+	return dataframe.NewDataFrame(seriess...), nil
+}
+
+type leafColumn struct {
+	elem *parquet.SchemaElement
+	path string // Full path of the column (excluding the root)
+}
+
+// leafColumns returns the leaf columns of a (depth-first) flattened schema in order.
+// The first element is the root. An error is returned if the schema contains repeated fields
+// since their values can't be stored in a series without losing the row alignment.
+func leafColumns(schema []*parquet.SchemaElement) ([]leafColumn, error) {
+
+	if len(schema) == 0 {
+		return nil, errors.New("parquet schema is empty")
+	}
+
+	var (
+		out  []leafColumn
+		path []string
+		idx  = 1
+	)
+
+	// walk visits the n children starting at schema[idx]
+	var walk func(n int32) error
+	walk = func(n int32) error {
+		for i := int32(0); i < n; i++ {
+			if idx >= len(schema) {
+				return errors.New("parquet schema is malformed")
+			}
+
+			elem := schema[idx]
+			idx++
+
+			path = append(path, elem.Name)
+			fullPath := strings.Join(path, ".")
+
+			if elem.IsSetRepetitionType() && elem.GetRepetitionType() == parquet.FieldRepetitionType_REPEATED {
+				return fmt.Errorf("repeated field %s is not supported", fullPath)
+			}
+
+			if elem.GetNumChildren() > 0 {
+				if err := walk(elem.GetNumChildren()); err != nil {
+					return err
+				}
+			} else {
+				out = append(out, leafColumn{elem, fullPath})
+			}
+
+			path = path[:len(path)-1]
+		}
+		return nil
+	}
+
+	if err := walk(schema[0].GetNumChildren()); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// parquetFile implements source.ParquetFile for data held in memory.
+// Open returns an independent reader so columns can be read concurrently.
+type parquetFile struct {
+	*bytes.Reader
+	data []byte
+}
+
+func (f *parquetFile) Open(name string) (source.ParquetFile, error) {
+	return &parquetFile{bytes.NewReader(f.data), f.data}, nil
+}
+
+func (f *parquetFile) Create(name string) (source.ParquetFile, error) {
+	return nil, errors.New("parquet file is read-only")
+}
+
+func (f *parquetFile) Write(p []byte) (int, error) {
+	return 0, errors.New("parquet file is read-only")
+}
+
+func (f *parquetFile) Close() error {
+	return nil
+}
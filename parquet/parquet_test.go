@@ -0,0 +1,124 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package parquet
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/xitongsys/parquet-go/parquet"
+
+	dataframe "github.com/rocketlaunchr/dataframe-go"
+)
+
+func TestParquetRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	ts := time.Date(2019, 1, 2, 3, 4, 5, 6000000, time.UTC)
+
+	df := dataframe.NewDataFrame(
+		dataframe.NewSeriesFloat64("f", nil, 1.5, nil, -2.25),
+		dataframe.NewSeriesInt64("i", nil, nil, 2, -3),
+		dataframe.NewSeriesBool("b", nil, true, false, nil),
+		dataframe.NewSeriesTime("t", nil, ts, nil, ts.Add(time.Hour)),
+		dataframe.NewSeriesString("s", nil, "a", "", nil),
+		dataframe.NewSeriesGeneric("g", 0, nil, 1, nil, 3),
+	)
+
+	var buf bytes.Buffer
+	if err := ExportToParquet(ctx, &buf, df); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	loaded, err := LoadFromParquet(ctx, bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Generic series are exported as strings
+	expected := dataframe.NewDataFrame(
+		dataframe.NewSeriesFloat64("f", nil, 1.5, nil, -2.25),
+		dataframe.NewSeriesInt64("i", nil, nil, 2, -3),
+		dataframe.NewSeriesBool("b", nil, true, false, nil),
+		dataframe.NewSeriesTime("t", nil, ts, nil, ts.Add(time.Hour)),
+		dataframe.NewSeriesString("s", nil, "a", "", nil),
+		dataframe.NewSeriesString("g", nil, "1", nil, "3"),
+	)
+
+	eq, err := loaded.IsEqual(expected)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !eq {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), loaded.Table())
+	}
+}
+
+func TestParquetExportInvalidName(t *testing.T) {
+	ctx := context.Background()
+
+	for _, name := range []string{"a,b", "a=b", " a"} {
+		df := dataframe.NewDataFrame(dataframe.NewSeriesInt64(name, nil, 1))
+
+		var buf bytes.Buffer
+		if err := ExportToParquet(ctx, &buf, df); err == nil {
+			t.Errorf("expected error for series name %q", name)
+		}
+	}
+}
+
+func TestLeafColumns(t *testing.T) {
+
+	n := func(v int32) *int32 { return &v }
+	rt := func(v parquet.FieldRepetitionType) *parquet.FieldRepetitionType { return &v }
+
+	optional := rt(parquet.FieldRepetitionType_OPTIONAL)
+	required := rt(parquet.FieldRepetitionType_REQUIRED)
+
+	// Leaf names are repeated across groups
+	schema := []*parquet.SchemaElement{
+		{Name: "root", NumChildren: n(3)},
+		{Name: "id", RepetitionType: required},
+		{Name: "home", NumChildren: n(2), RepetitionType: optional},
+		{Name: "city", RepetitionType: optional},
+		{Name: "zip", RepetitionType: optional},
+		{Name: "work", NumChildren: n(1), RepetitionType: required},
+		{Name: "city", RepetitionType: optional},
+	}
+
+	leaves, err := leafColumns(schema)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actual := []string{}
+	for _, l := range leaves {
+		actual = append(actual, l.path)
+	}
+
+	expected := []string{"id", "home.city", "home.zip", "work.city"}
+	if fmt.Sprint(actual) != fmt.Sprint(expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual)
+	}
+
+	// Repeated fields (eg. lists) are not supported
+	schema = []*parquet.SchemaElement{
+		{Name: "root", NumChildren: n(2)},
+		{Name: "id", RepetitionType: required},
+		{Name: "tags", NumChildren: n(1), RepetitionType: optional},
+		{Name: "list", NumChildren: n(1), RepetitionType: rt(parquet.FieldRepetitionType_REPEATED)},
+		{Name: "element", RepetitionType: optional},
+	}
+
+	if _, err := leafColumns(schema); err == nil {
+		t.Errorf("expected error for repeated field")
+	}
+
+	// Malformed
+	if _, err := leafColumns(schema[:3]); err == nil {
+		t.Errorf("expected error for malformed schema")
+	}
+}
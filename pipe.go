@@ -0,0 +1,30 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+// Pipe threads s through a sequence of transformations. The output of each function
+// is provided as the input of the next. Pipe stops and returns the error as soon as a
+// function returns an error.
+//
+// Example:
+//
+//  cleaned, err := dataframe.Pipe(s,
+//     func(s dataframe.Series) (dataframe.Series, error) {
+//        return s.(*dataframe.SeriesFloat64).RollingMean(3)
+//     },
+//     func(s dataframe.Series) (dataframe.Series, error) {
+//        s.(*dataframe.SeriesFloat64).TreatSmallAsNil(1e-9)
+//        return s, nil
+//     },
+//  )
+//
+func Pipe(s Series, fns ...func(Series) (Series, error)) (Series, error) {
+	for _, fn := range fns {
+		var err error
+		s, err = fn(s)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
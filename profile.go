@@ -0,0 +1,131 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+import (
+	"context"
+	"math"
+)
+
+// ProfileOptions is used to modify the behaviour of Profile().
+type ProfileOptions struct {
+	// Don't apply read lock to the dataframe.
+	DontLock bool
+}
+
+// Profile generates a data-quality report for df. Each row of the returned
+// dataframe summarizes one series: its name, type, number of non-nil values,
+// number of nil values and number of distinct values.
+// For numeric series (float64 and int64), the mean, sample standard deviation,
+// minimum and maximum of the non-nil values are also reported.
+// Statistics that are not applicable to a series are nil.
+//
+// Example:
+//
+//  report, _ := dataframe.Profile(ctx, df)
+//  fmt.Print(report.Table())
+//
+func Profile(ctx context.Context, df *DataFrame, options ...ProfileOptions) (*DataFrame, error) {
+
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	init := &SeriesInit{Capacity: len(df.Series)}
+
+	var (
+		columns  = NewSeriesString("column", init)
+		types    = NewSeriesString("type", init)
+		counts   = NewSeriesInt64("count", init)
+		nilCount = NewSeriesInt64("nil_count", init)
+		nUnique  = NewSeriesInt64("n_unique", init)
+		means    = NewSeriesFloat64("mean", init)
+		stds     = NewSeriesFloat64("std", init)
+		mins     = NewSeriesFloat64("min", init)
+		maxs     = NewSeriesFloat64("max", init)
+	)
+
+	for _, aSeries := range df.Series {
+
+		var numeric bool
+		switch aSeries.(type) {
+		case *SeriesFloat64, *SeriesInt64:
+			numeric = true
+		}
+
+		var (
+			count, nils int64
+			mean, m2    float64 // See: https://en.wikipedia.org/wiki/Algorithms_for_calculating_variance#Welford's_online_algorithm
+			min, max    = math.Inf(1), math.Inf(-1)
+		)
+
+		for row := 0; row < df.n; row++ {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			val := aSeries.Value(row)
+			if val == nil {
+				nils++
+				continue
+			}
+			count++
+
+			if !numeric {
+				continue
+			}
+
+			var f float64
+			switch v := val.(type) {
+			case float64:
+				f = v
+			case int64:
+				f = float64(v)
+			}
+
+			delta := f - mean
+			mean = mean + delta/float64(count)
+			m2 = m2 + delta*(f-mean)
+
+			if f < min {
+				min = f
+			}
+			if f > max {
+				max = f
+			}
+		}
+
+		columns.Append(aSeries.Name())
+		types.Append(aSeries.Type())
+		counts.Append(count)
+		nilCount.Append(nils)
+
+		if nu, ok := aSeries.(interface {
+			NUnique(...NUniqueOptions) int
+		}); ok {
+			nUnique.Append(nu.NUnique())
+		} else {
+			nUnique.Append(nil)
+		}
+
+		if !numeric || count == 0 {
+			means.Append(nil)
+			stds.Append(nil)
+			mins.Append(nil)
+			maxs.Append(nil)
+			continue
+		}
+
+		means.Append(mean)
+		if count > 1 {
+			stds.Append(math.Sqrt(m2 / float64(count-1)))
+		} else {
+			stds.Append(nil)
+		}
+		mins.Append(min)
+		maxs.Append(max)
+	}
+
+	return NewDataFrame(columns, types, counts, nilCount, nUnique, means, stds, mins, maxs), nil
+}
@@ -0,0 +1,151 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ResampleOptions is used to modify the behaviour of Resample().
+type ResampleOptions struct {
+
+	// FillEmpty determines how buckets that contain no rows are filled.
+	// If not set, empty buckets are nil.
+	FillEmpty *FillStrategy
+
+	// MaxBuckets is the maximum number of buckets that can be created.
+	// It guards against a freq that is too small for the range of times.
+	// If not set, 1,000,000 is used.
+	MaxBuckets int
+}
+
+const defaultMaxBuckets = 1000000
+
+// Resample buckets the rows of values into fixed intervals of freq based on times and aggregates
+// each bucket using agg. It returns the aggregated values along with the start time of each bucket.
+// The buckets begin at the earliest time (truncated to a multiple of freq) and are contiguous until the latest time.
+// times does not need to be sorted. Rows with a nil time are ignored.
+// values and times are read locked for the duration of the operation.
+// An error is returned if more than MaxBuckets buckets are required.
+//
+// This is useful for preparing irregularly sampled data for algorithms that assume regular spacing
+// (eg. HoltWinters).
+//
+// Example:
+//
+//  hourly, hours, err := dataframe.Resample(ctx, values, times, time.Hour, dataframe.AggMean)
+//
+func Resample(ctx context.Context, values *SeriesFloat64, times *SeriesTime, freq time.Duration, agg AggFn, options ...ResampleOptions) (*SeriesFloat64, *SeriesTime, error) {
+
+	if freq <= 0 {
+		return nil, nil, errors.New("freq must be positive")
+	}
+
+	maxBuckets := defaultMaxBuckets
+	if len(options) > 0 && options[0].MaxBuckets > 0 {
+		maxBuckets = options[0].MaxBuckets
+	}
+
+	values.lock.RLock()
+	defer values.lock.RUnlock()
+	times.lock.RLock()
+	defer times.lock.RUnlock()
+
+	nRows := len(values.Values)
+	if nRows != times.NRows(DontLock) {
+		return nil, nil, ErrMismatchedRows
+	}
+
+	// agg reads from a snapshot so the read lock on values is not acquired recursively
+	snapshot := values.Copy().(*SeriesFloat64)
+
+	// Determine range
+	var (
+		start, end time.Time
+		found      bool
+	)
+
+	for row := 0; row < nRows; row++ {
+		t, ok := times.Value(row, DontLock).(time.Time)
+		if !ok {
+			continue
+		}
+		if !found || t.Before(start) {
+			start = t
+		}
+		if !found || t.After(end) {
+			end = t
+		}
+		found = true
+	}
+
+	if !found {
+		return nil, nil, ErrNoValues
+	}
+
+	start = start.Truncate(freq)
+	if n := end.Sub(start) / freq; n >= time.Duration(maxBuckets) {
+		return nil, nil, fmt.Errorf("too many buckets required: %d (max %d)", int64(n)+1, maxBuckets)
+	}
+	nBuckets := int(end.Sub(start)/freq) + 1
+
+	buckets := make([][]int, nBuckets)
+	for row := 0; row < nRows; row++ {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		t, ok := times.Value(row, DontLock).(time.Time)
+		if !ok {
+			continue
+		}
+		idx := int(t.Sub(start) / freq)
+		buckets[idx] = append(buckets[idx], row)
+	}
+
+	outVals := NewSeriesFloat64(values.Name(), &SeriesInit{Capacity: nBuckets})
+	outTimes := NewSeriesTime(times.Name(), &SeriesInit{Capacity: nBuckets})
+
+	var empty []int
+	for idx, rows := range buckets {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		outTimes.Append(start.Add(time.Duration(idx) * freq))
+
+		if len(rows) == 0 {
+			empty = append(empty, idx)
+			outVals.Append(nil)
+			continue
+		}
+
+		val, err := agg(snapshot, rows)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		switch v := val.(type) {
+		case nil, float64:
+			outVals.Append(v)
+		case int64:
+			outVals.Append(float64(v))
+		default:
+			return nil, nil, fmt.Errorf("unsupported aggregated type: %T", v)
+		}
+	}
+
+	if len(options) > 0 && options[0].FillEmpty != nil && len(empty) > 0 {
+		filled := outVals.Copy().(*SeriesFloat64)
+		filled.FillNaN(*options[0].FillEmpty)
+
+		for _, idx := range empty {
+			outVals.Update(idx, filled.Value(idx))
+		}
+	}
+
+	return outVals, outTimes, nil
+}
@@ -0,0 +1,242 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+import (
+	"errors"
+	"math"
+)
+
+// RollingOptions is used to modify the behaviour of the rolling window functions.
+type RollingOptions struct {
+	// Don't apply read lock to the series.
+	DontLock bool
+
+	// Center will make the window symmetric around each row instead of ending at each row.
+	// For even window sizes, the window contains one more row before the current row than after it.
+	Center bool
+
+	// MinPeriods is the minimum number of non-nil values required in a window for it to produce a value.
+	// nil values and rows that fall outside the series are skipped. The default (0) requires the entire
+	// window to fit within the series and contain no nil values.
+	MinPeriods int
+}
+
+// RollingFloat64 is used to calculate aggregations over a rolling window of a SeriesFloat64.
+// It is created using Rolling().
+type RollingFloat64 struct {
+	s       *SeriesFloat64
+	window  int
+	options []RollingOptions
+}
+
+// Rolling returns a RollingFloat64 which is used to calculate aggregations over
+// each window of the provided size. By default, the window ends at (and includes) each row.
+//
+// Example:
+//
+//  smoothed, err := s.Rolling(7, dataframe.RollingOptions{MinPeriods: 1}).Mean()
+//
+func (s *SeriesFloat64) Rolling(window int, options ...RollingOptions) *RollingFloat64 {
+	return &RollingFloat64{s: s, window: window, options: options}
+}
+
+// Mean returns a new series containing the mean of each window.
+func (r *RollingFloat64) Mean() (*SeriesFloat64, error) {
+	return r.s.rolling(r.window, func(vals []float64) float64 {
+		var sum float64
+		for _, v := range vals {
+			sum = sum + v
+		}
+		return sum / float64(len(vals))
+	}, r.options...)
+}
+
+// Sum returns a new series containing the sum of each window.
+func (r *RollingFloat64) Sum() (*SeriesFloat64, error) {
+	return r.s.rolling(r.window, func(vals []float64) float64 {
+		var sum float64
+		for _, v := range vals {
+			sum = sum + v
+		}
+		return sum
+	}, r.options...)
+}
+
+// Min returns a new series containing the smallest value of each window.
+func (r *RollingFloat64) Min() (*SeriesFloat64, error) {
+	return r.s.rolling(r.window, func(vals []float64) float64 {
+		min := vals[0]
+		for _, v := range vals[1:] {
+			if v < min {
+				min = v
+			}
+		}
+		return min
+	}, r.options...)
+}
+
+// Max returns a new series containing the largest value of each window.
+func (r *RollingFloat64) Max() (*SeriesFloat64, error) {
+	return r.s.rolling(r.window, func(vals []float64) float64 {
+		max := vals[0]
+		for _, v := range vals[1:] {
+			if v > max {
+				max = v
+			}
+		}
+		return max
+	}, r.options...)
+}
+
+// StdDev returns a new series containing the sample standard deviation (using the n-1 denominator)
+// of each window. A window with less than 2 values produces nil.
+func (r *RollingFloat64) StdDev() (*SeriesFloat64, error) {
+	return r.s.rolling(r.window, func(vals []float64) float64 {
+		if len(vals) < 2 {
+			return nan()
+		}
+
+		var mean float64
+		for _, v := range vals {
+			mean = mean + v
+		}
+		mean = mean / float64(len(vals))
+
+		var ss float64
+		for _, v := range vals {
+			ss = ss + (v-mean)*(v-mean)
+		}
+		return math.Sqrt(ss / float64(len(vals)-1))
+	}, r.options...)
+}
+
+// RollingMean returns a new series containing the mean of each window of the provided size.
+// By default, the window ends at (and includes) each row.
+// A row is nil if its window doesn't fit within the series or contains a nil value.
+func (s *SeriesFloat64) RollingMean(window int, options ...RollingOptions) (*SeriesFloat64, error) {
+	return s.Rolling(window, options...).Mean()
+}
+
+// RollingSum returns a new series containing the sum of each window of the provided size.
+// By default, the window ends at (and includes) each row.
+// A row is nil if its window doesn't fit within the series or contains a nil value.
+func (s *SeriesFloat64) RollingSum(window int, options ...RollingOptions) (*SeriesFloat64, error) {
+	return s.Rolling(window, options...).Sum()
+}
+
+// rolling applies fn to the non-nil values of each window of the series.
+// fn is only called if the window contains at least MinPeriods non-nil values.
+func (s *SeriesFloat64) rolling(window int, fn func(vals []float64) float64, options ...RollingOptions) (*SeriesFloat64, error) {
+
+	if window <= 0 {
+		return nil, errors.New("window must be greater than 0")
+	}
+
+	var opts RollingOptions
+	if len(options) > 0 {
+		opts = options[0]
+	}
+
+	if opts.MinPeriods < 0 || opts.MinPeriods > window {
+		return nil, errors.New("MinPeriods must be between 0 and window")
+	}
+
+	minPeriods := opts.MinPeriods
+	if minPeriods == 0 {
+		minPeriods = window
+	}
+
+	if !opts.DontLock {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	// Number of rows in the window before the current row
+	before := window - 1
+	if opts.Center {
+		before = window / 2
+	}
+
+	n := len(s.Values)
+	ns := NewSeriesFloat64(s.name, &SeriesInit{Capacity: n})
+	vals := make([]float64, 0, window)
+
+	for row := 0; row < n; row++ {
+		start := row - before
+		end := start + window // exclusive
+
+		if start < 0 {
+			start = 0
+		}
+		if end > n {
+			end = n
+		}
+
+		vals = vals[:0]
+		for _, v := range s.Values[start:end] {
+			if !isNaN(v) {
+				vals = append(vals, v)
+			}
+		}
+
+		if len(vals) < minPeriods {
+			ns.Append(nil)
+		} else {
+			ns.Append(fn(vals))
+		}
+	}
+
+	return ns, nil
+}
+
+// EWMStd returns a new series containing the exponentially weighted moving standard deviation
+// (RiskMetrics volatility) of the series using the recursion:
+//
+//  σ²(t) = λ*σ²(t-1) + (1-λ)*y(t)²
+//
+// As per RiskMetrics, the deviations are taken from a mean of zero, so the series
+// is expected to contain returns. The recursion is seeded with the square of the first
+// non-nil value. Each value therefore only depends on the current and preceding values.
+// lambda must be between 0 and 1 (exclusive).
+// nil values carry forward the previous standard deviation. Leading nil values remain nil.
+func (s *SeriesFloat64) EWMStd(lambda float64, options ...Options) (*SeriesFloat64, error) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	if lambda <= 0 || lambda >= 1 {
+		return nil, errors.New("lambda must be between 0 and 1 (exclusive)")
+	}
+
+	if len(s.Values) == s.nilCount {
+		return nil, ErrNoValues
+	}
+
+	var variance float64
+	started := false
+
+	ns := NewSeriesFloat64(s.name, &SeriesInit{Capacity: len(s.Values)})
+
+	for _, v := range s.Values {
+		if isNaN(v) {
+			if started {
+				ns.Append(math.Sqrt(variance))
+			} else {
+				ns.Append(nil)
+			}
+			continue
+		}
+
+		if started {
+			variance = lambda*variance + (1-lambda)*v*v
+		} else {
+			variance = v * v // Seed
+			started = true
+		}
+		ns.Append(math.Sqrt(variance))
+	}
+
+	return ns, nil
+}
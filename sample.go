@@ -0,0 +1,139 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+)
+
+// SampleOptions is used to modify the behaviour of Sample() and SampleSeries().
+type SampleOptions struct {
+	// Don't apply lock to the dataframe or series.
+	DontLock bool
+
+	// Replace will sample with replacement, allowing a row to be selected more than once.
+	Replace bool
+}
+
+// Sample returns a new dataframe containing n randomly selected rows of df.
+// Rows are selected without replacement unless Replace is set.
+// The same seed will always select the same rows.
+//
+// Example:
+//
+//  sample, err := dataframe.Sample(ctx, df, 100, 42)
+//
+func Sample(ctx context.Context, df *DataFrame, n int, seed int64, options ...SampleOptions) (*DataFrame, error) {
+
+	var opts SampleOptions
+	if len(options) > 0 {
+		opts = options[0]
+	}
+
+	if !opts.DontLock {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	rows, err := sampleRows(df.n, n, seed, opts.Replace)
+	if err != nil {
+		return nil, err
+	}
+
+	return df.subset(ctx, rows)
+}
+
+// SampleSeries returns a new series containing n randomly selected rows of s.
+// Rows are selected without replacement unless Replace is set.
+// The same seed will always select the same rows.
+func SampleSeries(ctx context.Context, s Series, n int, seed int64, options ...SampleOptions) (Series, error) {
+
+	var opts SampleOptions
+	if len(options) > 0 {
+		opts = options[0]
+	}
+
+	if !opts.DontLock {
+		s.Lock()
+		defer s.Unlock()
+	}
+
+	rows, err := sampleRows(s.NRows(DontLock), n, seed, opts.Replace)
+	if err != nil {
+		return nil, err
+	}
+
+	return subset(ctx, s, rows, DontLock)
+}
+
+// SplitOptions is used to modify the behaviour of Split().
+type SplitOptions struct {
+	// Don't apply read lock to the dataframe.
+	DontLock bool
+}
+
+// Split randomly partitions the rows of df into 2 new dataframes.
+// train contains fraction (between [0,1]) of the rows (rounded to the nearest row) and test contains the remainder.
+// The same seed will always produce the same partitions.
+//
+// Example:
+//
+//  train, test, err := dataframe.Split(ctx, df, 0.8, 42)
+//
+func Split(ctx context.Context, df *DataFrame, fraction float64, seed int64, options ...SplitOptions) (train, test *DataFrame, err error) {
+
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	if fraction < 0 || fraction > 1 || math.IsNaN(fraction) {
+		return nil, nil, errors.New("fraction must be between [0,1]")
+	}
+
+	rows := rand.New(rand.NewSource(seed)).Perm(df.n)
+	nTrain := int(math.Round(fraction * float64(df.n)))
+
+	train, err = df.subset(ctx, rows[:nTrain])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	test, err = df.subset(ctx, rows[nTrain:])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return train, test, nil
+}
+
+// sampleRows randomly selects n rows from nRows rows.
+func sampleRows(nRows, n int, seed int64, replace bool) ([]int, error) {
+
+	if n < 0 {
+		return nil, errors.New("n must not be negative")
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+
+	if replace {
+		if nRows == 0 && n > 0 {
+			return nil, ErrNoRows
+		}
+
+		rows := make([]int, n)
+		for i := range rows {
+			rows[i] = rng.Intn(nRows)
+		}
+		return rows, nil
+	}
+
+	if n > nRows {
+		return nil, errors.New("n must not be greater than the number of rows")
+	}
+
+	return rng.Perm(nRows)[:n], nil
+}
@@ -12,6 +12,15 @@ type Options struct {
 	SortDesc bool
 }
 
+// NUniqueOptions is used to modify the behaviour of NUnique().
+type NUniqueOptions struct {
+	// Don't apply lock
+	DontLock bool
+
+	// IncludeNil counts nil values as their own distinct value.
+	IncludeNil bool
+}
+
 // ValueToStringFormatter is used to convert a value
 // into a string. Val can be nil or the concrete
 // type stored by the series.
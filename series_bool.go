@@ -0,0 +1,859 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// SeriesBool is used for series containing bool data.
+type SeriesBool struct {
+	valFormatter ValueToStringFormatter
+
+	lock     sync.RWMutex
+	name     string
+	values   []*bool
+	nilCount int
+}
+
+// NewSeriesBool creates a new series with the underlying type as bool.
+// String values are parsed using strconv.ParseBool (eg. "true", "1", "false", "0").
+func NewSeriesBool(name string, init *SeriesInit, vals ...interface{}) *SeriesBool {
+	s := &SeriesBool{
+		name:     name,
+		values:   []*bool{},
+		nilCount: 0,
+	}
+
+	var (
+		size     int
+		capacity int
+	)
+
+	if init != nil {
+		size = init.Size
+		capacity = init.Capacity
+		if size > capacity {
+			capacity = size
+		}
+	}
+
+	s.values = make([]*bool, size, capacity)
+	s.valFormatter = DefaultValueFormatter
+
+	for idx, v := range vals {
+		val := s.valToPointer(v)
+		if val == nil {
+			s.nilCount++
+		}
+
+		if idx < size {
+			s.values[idx] = val
+		} else {
+			s.values = append(s.values, val)
+		}
+	}
+
+	if len(vals) < size {
+		s.nilCount = s.nilCount + size - len(vals)
+	}
+
+	return s
+}
+
+// Name returns the series name.
+func (s *SeriesBool) Name() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.name
+}
+
+// Rename renames the series.
+func (s *SeriesBool) Rename(n string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.name = n
+}
+
+// Type returns the type of data the series holds.
+func (s *SeriesBool) Type() string {
+	return "bool"
+}
+
+// NRows returns how many rows the series contains.
+func (s *SeriesBool) NRows(options ...Options) int {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	return len(s.values)
+}
+
+// Value returns the value of a particular row.
+// The return value could be nil or the concrete type
+// the data type held by the series.
+// Pointers are never returned.
+func (s *SeriesBool) Value(row int, options ...Options) interface{} {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	val := s.values[row]
+	if val == nil {
+		return nil
+	}
+	return *val
+}
+
+// BoolAt returns the value of a particular row without boxing it in an interface{}.
+// ok is false if the value is nil, in which case the zero value is returned.
+func (s *SeriesBool) BoolAt(row int, options ...Options) (val bool, ok bool) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	v := s.values[row]
+	if v == nil {
+		return false, false
+	}
+	return *v, true
+}
+
+// ValueString returns a string representation of a
+// particular row. The string representation is defined
+// by the function set in SetValueToStringFormatter.
+// By default, a nil value is returned as "NaN".
+func (s *SeriesBool) ValueString(row int, options ...Options) string {
+	return s.valFormatter(s.Value(row, options...))
+}
+
+// Prepend is used to set a value to the beginning of the
+// series. val can be a concrete data type or nil. Nil
+// represents the absence of a value.
+func (s *SeriesBool) Prepend(val interface{}, options ...Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	// See: https://stackoverflow.com/questions/41914386/what-is-the-mechanism-of-using-append-to-prepend-in-go
+
+	if cap(s.values) > len(s.values) {
+		// There is already extra capacity so copy current values by 1 spot
+		s.values = s.values[:len(s.values)+1]
+		copy(s.values[1:], s.values)
+		s.values[0] = s.valToPointer(val)
+		if s.values[0] == nil {
+			s.nilCount++
+		}
+		return
+	}
+
+	// No room, new slice needs to be allocated:
+	s.insert(0, val)
+}
+
+// Append is used to set a value to the end of the series.
+// val can be a concrete data type or nil. Nil represents
+// the absence of a value.
+func (s *SeriesBool) Append(val interface{}, options ...Options) int {
+	var locked bool
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		locked = true
+	}
+
+	row := s.NRows(Options{DontLock: locked})
+	s.insert(row, val)
+	return row
+}
+
+// Insert is used to set a value at an arbitrary row in
+// the series. All existing values from that row onwards
+// are shifted by 1. val can be a concrete data type or nil.
+// Nil represents the absence of a value.
+func (s *SeriesBool) Insert(row int, val interface{}, options ...Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	s.insert(row, val)
+}
+
+func (s *SeriesBool) insert(row int, val interface{}) {
+	switch V := val.(type) {
+	case []bool:
+		var vals []*bool
+		for _, v := range V {
+			v := v
+			vals = append(vals, &v)
+		}
+		s.values = append(s.values[:row], append(vals, s.values[row:]...)...)
+		return
+	case []*bool:
+		for _, v := range V {
+			if v == nil {
+				s.nilCount++
+			}
+		}
+		s.values = append(s.values[:row], append(V, s.values[row:]...)...)
+		return
+	}
+
+	s.values = append(s.values, nil)
+	copy(s.values[row+1:], s.values[row:])
+
+	v := s.valToPointer(val)
+	if v == nil {
+		s.nilCount++
+	}
+
+	s.values[row] = v
+}
+
+// Remove is used to delete the value of a particular row.
+func (s *SeriesBool) Remove(row int, options ...Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	if s.values[row] == nil {
+		s.nilCount--
+	}
+
+	s.values = append(s.values[:row], s.values[row+1:]...)
+}
+
+// RemoveRows is used to delete the values of multiple rows in a single pass.
+// rows does not need to be sorted. An error is returned (without modifying the series)
+// if rows contains a duplicate or out of range row.
+func (s *SeriesBool) RemoveRows(rows []int, options ...Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	sorted, err := sortedRows(rows, len(s.values))
+	if err != nil {
+		return err
+	}
+
+	var j, k int
+	for i, v := range s.values {
+		if k < len(sorted) && sorted[k] == i {
+			if v == nil {
+				s.nilCount--
+			}
+			k++
+			continue
+		}
+		s.values[j] = v
+		j++
+	}
+
+	// Release references held by the unused portion of the underlying slice
+	for i := j; i < len(s.values); i++ {
+		s.values[i] = nil
+	}
+
+	s.values = s.values[:j]
+
+	return nil
+}
+
+// TrimCapacity will reallocate the underlying slice so that its capacity
+// matches the number of rows. Removing rows does not release the memory
+// held by the underlying slice, so this should be called after many rows
+// have been removed.
+func (s *SeriesBool) TrimCapacity(options ...Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	if cap(s.values) == len(s.values) {
+		return
+	}
+
+	newSlice := make([]*bool, len(s.values))
+	copy(newSlice, s.values)
+	s.values = newSlice
+}
+
+// Update is used to update the value of a particular row.
+// val can be a concrete data type or nil. Nil represents
+// the absence of a value.
+func (s *SeriesBool) Update(row int, val interface{}, options ...Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	newVal := s.valToPointer(val)
+
+	if s.values[row] == nil && newVal != nil {
+		s.nilCount--
+	} else if s.values[row] != nil && newVal == nil {
+		s.nilCount++
+	}
+
+	s.values[row] = newVal
+}
+
+func (s *SeriesBool) valToPointer(v interface{}) *bool {
+	switch val := v.(type) {
+	case nil:
+		return nil
+	case *bool:
+		if val == nil {
+			return nil
+		}
+		return &[]bool{*val}[0]
+	case bool:
+		return &val
+	case string:
+		b, err := strconv.ParseBool(val)
+		if err != nil {
+			panic(err)
+		}
+		return &b
+	default:
+		_ = v.(bool) // Intentionally panic
+		return nil
+	}
+}
+
+// SetValueToStringFormatter is used to set a function
+// to convert the value of a particular row to a string
+// representation.
+func (s *SeriesBool) SetValueToStringFormatter(f ValueToStringFormatter) {
+	if f == nil {
+		s.valFormatter = DefaultValueFormatter
+		return
+	}
+	s.valFormatter = f
+}
+
+// Swap is used to swap 2 values based on their row position.
+func (s *SeriesBool) Swap(row1, row2 int, options ...Options) {
+	if row1 == row2 {
+		return
+	}
+
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	s.values[row1], s.values[row2] = s.values[row2], s.values[row1]
+}
+
+// IsEqualFunc returns true if a is equal to b.
+func (s *SeriesBool) IsEqualFunc(a, b interface{}) bool {
+
+	if a == nil {
+		if b == nil {
+			return true
+		}
+		return false
+	}
+
+	if b == nil {
+		return false
+	}
+	t1 := a.(bool)
+	t2 := b.(bool)
+
+	return t1 == t2
+}
+
+// IsLessThanFunc returns true if a is less than b.
+func (s *SeriesBool) IsLessThanFunc(a, b interface{}) bool {
+
+	if a == nil {
+		if b == nil {
+			return true
+		}
+		return true
+	}
+
+	if b == nil {
+		return false
+	}
+	t1 := a.(bool)
+	t2 := b.(bool)
+
+	return !t1 && t2
+}
+
+// Sort will sort the series.
+func (s *SeriesBool) Sort(options ...Options) {
+
+	var sortDesc bool
+
+	if len(options) == 0 {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	} else {
+		if !options[0].DontLock {
+			s.lock.Lock()
+			defer s.lock.Unlock()
+		}
+		sortDesc = options[0].SortDesc
+	}
+
+	sort.SliceStable(s.values, func(i, j int) (ret bool) {
+		defer func() {
+			if sortDesc {
+				ret = !ret
+			}
+		}()
+
+		if s.values[i] == nil {
+			if s.values[j] == nil {
+				// both are nil
+				return true
+			}
+			return true
+		}
+
+		if s.values[j] == nil {
+			// i has value and j is nil
+			return false
+		}
+		// Both are not nil
+		ti := *s.values[i]
+		tj := *s.values[j]
+
+		return !ti && tj
+	})
+}
+
+// SortByKey reorders the series based on the sorted order of keys (eg. an external timestamp slice).
+// keys must contain the same number of values as the series. NaN keys are treated as nil.
+// The sort is stable. The SortDesc option is honored.
+func (s *SeriesBool) SortByKey(keys []float64, options ...Options) error {
+	return s.sortByKey(len(keys), float64KeyLess(keys), options...)
+}
+
+// SortByKeyInt64 is the same as SortByKey except keys are int64.
+func (s *SeriesBool) SortByKeyInt64(keys []int64, options ...Options) error {
+	return s.sortByKey(len(keys), int64KeyLess(keys), options...)
+}
+
+// SortByKeyGeneric is the same as SortByKey except keys can be of any type.
+// less is used to compare keys.
+func (s *SeriesBool) SortByKeyGeneric(keys []interface{}, less IsLessThanFunc, options ...Options) error {
+	return s.sortByKey(len(keys), genericKeyLess(keys, less), options...)
+}
+
+func (s *SeriesBool) sortByKey(n int, less func(i, j int) bool, options ...Options) error {
+
+	var sortDesc bool
+
+	if len(options) == 0 {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	} else {
+		if !options[0].DontLock {
+			s.lock.Lock()
+			defer s.lock.Unlock()
+		}
+		sortDesc = options[0].SortDesc
+	}
+
+	if n != len(s.values) {
+		return ErrMismatchedRows
+	}
+
+	newVals := make([]*bool, 0, len(s.values))
+	for _, row := range keyOrder(n, less, sortDesc) {
+		newVals = append(newVals, s.values[row])
+	}
+	s.values = newVals
+
+	return nil
+}
+
+// Lock will lock the Series allowing you to directly manipulate
+// the underlying slice with confidence.
+func (s *SeriesBool) Lock() {
+	s.lock.Lock()
+}
+
+// Unlock will unlock the Series that was previously locked.
+func (s *SeriesBool) Unlock() {
+	s.lock.Unlock()
+}
+
+// Copy will create a new copy of the series.
+// It is recommended that you lock the Series before attempting
+// to Copy.
+func (s *SeriesBool) Copy(r ...Range) Series {
+
+	if len(s.values) == 0 {
+		return &SeriesBool{
+			valFormatter: s.valFormatter,
+			name:         s.name,
+			values:       []*bool{},
+			nilCount:     s.nilCount,
+		}
+	}
+
+	if len(r) == 0 {
+		r = append(r, Range{})
+	}
+
+	start, end, err := r[0].Limits(len(s.values))
+	if err != nil {
+		panic(err)
+	}
+
+	// Copy slice
+	x := s.values[start : end+1]
+	newSlice := append(x[:0:0], x...)
+
+	var nilCount int
+	for _, v := range newSlice {
+		if v == nil {
+			nilCount++
+		}
+	}
+
+	return &SeriesBool{
+		valFormatter: s.valFormatter,
+		name:         s.name,
+		values:       newSlice,
+		nilCount:     nilCount,
+	}
+}
+
+// Head returns a copy of the first n rows.
+// If n is larger than the number of rows, all rows are returned.
+func (s *SeriesBool) Head(n int, options ...Options) Series {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	return headTail(s, n, false)
+}
+
+// Tail returns a copy of the last n rows.
+// If n is larger than the number of rows, all rows are returned.
+func (s *SeriesBool) Tail(n int, options ...Options) Series {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	return headTail(s, n, true)
+}
+
+// Table will produce the Series in a table.
+func (s *SeriesBool) Table(r ...Range) string {
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if len(r) == 0 {
+		r = append(r, Range{})
+	}
+
+	data := [][]string{}
+
+	headers := []string{"", s.name} // row header is blank
+	footers := []string{fmt.Sprintf("%dx%d", len(s.values), 1), s.Type()}
+
+	if len(s.values) > 0 {
+
+		start, end, err := r[0].Limits(len(s.values))
+		if err != nil {
+			panic(err)
+		}
+
+		for row := start; row <= end; row++ {
+			sVals := []string{fmt.Sprintf("%d:", row), s.ValueString(row, Options{true, false})}
+			data = append(data, sVals)
+		}
+
+	}
+
+	var buf bytes.Buffer
+
+	table := tablewriter.NewWriter(&buf)
+	table.SetHeader(headers)
+	for _, v := range data {
+		table.Append(v)
+	}
+	table.SetFooter(footers)
+	table.SetAlignment(tablewriter.ALIGN_CENTER)
+
+	table.Render()
+
+	return buf.String()
+}
+
+// String implements Stringer interface.
+func (s *SeriesBool) String() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	count := len(s.values)
+
+	out := "[ "
+
+	if count > 6 {
+		idx := []int{0, 1, 2, count - 3, count - 2, count - 1}
+		for j, row := range idx {
+			if j == 3 {
+				out = out + "... "
+			}
+			out = out + s.ValueString(row, Options{true, false}) + " "
+		}
+		return out + "]"
+	}
+
+	for row := range s.values {
+		out = out + s.ValueString(row, Options{true, false}) + " "
+	}
+	return out + "]"
+}
+
+// ContainsNil will return whether or not the series contains any nil values.
+func (s *SeriesBool) ContainsNil() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.nilCount > 0
+}
+
+// IsNull returns a mask containing true for each row with a nil value and false otherwise.
+// The mask can be combined with other masks and used with FilterByMask.
+func (s *SeriesBool) IsNull(options ...Options) *SeriesBool {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	mask := NewSeriesBool(s.name, &SeriesInit{Capacity: len(s.values)})
+	for _, v := range s.values {
+		mask.Append(v == nil)
+	}
+
+	return mask
+}
+
+// Validate recomputes the number of nil values and compares it against the
+// cached count, returning an error if they differ.
+// It is intended to be used as a self-check while debugging and in tests.
+func (s *SeriesBool) Validate(options ...Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	var nilCount int
+	for _, v := range s.values {
+		if v == nil {
+			nilCount++
+		}
+	}
+
+	if nilCount != s.nilCount {
+		return fmt.Errorf("nil count mismatch: cached: %d actual: %d", s.nilCount, nilCount)
+	}
+
+	return nil
+}
+
+// ForEach calls fn for each row of the series in order. val is nil for missing values.
+// Iteration stops as soon as fn returns an error, which is then returned by ForEach.
+// The series is read locked once for the duration of the iteration, so fn must not
+// modify the series.
+func (s *SeriesBool) ForEach(fn func(row int, val interface{}) error, options ...Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	for row := range s.values {
+		if err := fn(row, s.Value(row, Options{true, false})); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NUnique returns the number of distinct non-nil values.
+func (s *SeriesBool) NUnique(options ...NUniqueOptions) int {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	var hasNil bool
+	unique := map[bool]struct{}{}
+	for _, v := range s.values {
+		if v == nil {
+			hasNil = true
+			continue
+		}
+		unique[*v] = struct{}{}
+	}
+
+	if len(options) > 0 && options[0].IncludeNil && hasNil {
+		return len(unique) + 1
+	}
+	return len(unique)
+}
+
+// Unique returns the distinct non-nil values in the order they first appear.
+// If IncludeNil is set, nil is also returned (once) if the series contains nil values.
+func (s *SeriesBool) Unique(options ...NUniqueOptions) []interface{} {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	includeNil := len(options) > 0 && options[0].IncludeNil
+
+	var hasNil bool
+	out := []interface{}{}
+	seen := map[bool]struct{}{}
+	for _, v := range s.values {
+		if v == nil {
+			if includeNil && !hasNil {
+				out = append(out, nil)
+			}
+			hasNil = true
+			continue
+		}
+		if _, exists := seen[*v]; !exists {
+			seen[*v] = struct{}{}
+			out = append(out, *v)
+		}
+	}
+
+	return out
+}
+
+// ValueCounts returns the number of occurrences of each distinct non-nil value.
+// If IncludeNil is set, the number of nil values is stored with a nil key.
+func (s *SeriesBool) ValueCounts(options ...NUniqueOptions) map[interface{}]int {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	out := map[interface{}]int{}
+	for _, v := range s.values {
+		if v == nil {
+			if len(options) > 0 && options[0].IncludeNil {
+				out[nil]++
+			}
+			continue
+		}
+		out[*v]++
+	}
+
+	return out
+}
+
+// And performs a logical AND with other and returns a new mask.
+// Nil values follow SQL's three-valued logic:
+// nil AND false is false, nil AND true is nil.
+// other must contain the same number of rows.
+func (s *SeriesBool) And(other *SeriesBool) (*SeriesBool, error) {
+	return s.logic(other, func(a, b *bool) *bool {
+		if (a != nil && !*a) || (b != nil && !*b) {
+			return &[]bool{false}[0]
+		}
+		if a == nil || b == nil {
+			return nil
+		}
+		return &[]bool{true}[0]
+	})
+}
+
+// Or performs a logical OR with other and returns a new mask.
+// Nil values follow SQL's three-valued logic:
+// nil OR true is true, nil OR false is nil.
+// other must contain the same number of rows.
+func (s *SeriesBool) Or(other *SeriesBool) (*SeriesBool, error) {
+	return s.logic(other, func(a, b *bool) *bool {
+		if (a != nil && *a) || (b != nil && *b) {
+			return &[]bool{true}[0]
+		}
+		if a == nil || b == nil {
+			return nil
+		}
+		return &[]bool{false}[0]
+	})
+}
+
+// Xor performs a logical XOR with other and returns a new mask.
+// If either value is nil, the result is nil.
+// other must contain the same number of rows.
+func (s *SeriesBool) Xor(other *SeriesBool) (*SeriesBool, error) {
+	return s.logic(other, func(a, b *bool) *bool {
+		if a == nil || b == nil {
+			return nil
+		}
+		return &[]bool{*a != *b}[0]
+	})
+}
+
+// Not performs a logical NOT and returns a new mask.
+// Nil values remain nil.
+func (s *SeriesBool) Not() *SeriesBool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	mask := NewSeriesBool(s.name, &SeriesInit{Capacity: len(s.values)})
+	for _, v := range s.values {
+		if v == nil {
+			mask.Append(nil)
+			continue
+		}
+		mask.Append(!*v)
+	}
+
+	return mask
+}
+
+func (s *SeriesBool) logic(other *SeriesBool, fn func(a, b *bool) *bool) (*SeriesBool, error) {
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if other != s {
+		other.lock.RLock()
+		defer other.lock.RUnlock()
+	}
+
+	if len(other.values) != len(s.values) {
+		return nil, ErrMismatchedRows
+	}
+
+	mask := NewSeriesBool(s.name, &SeriesInit{Capacity: len(s.values)})
+	for row := range s.values {
+		mask.Append(fn(s.values[row], other.values[row]))
+	}
+
+	return mask, nil
+}
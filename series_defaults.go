@@ -4,6 +4,7 @@ package dataframe
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -22,3 +23,32 @@ func DefaultValueFormatter(v interface{}) string {
 	}
 	return fmt.Sprintf("%v", v)
 }
+
+// timeValueFormatter is the default ValueToStringFormatter for SeriesTime.
+// It formats time.Time values using RFC3339.
+func timeValueFormatter(v interface{}) string {
+	if t, ok := v.(time.Time); ok {
+		return t.Format(time.RFC3339)
+	}
+	return DefaultValueFormatter(v)
+}
+
+// NilValueFormatter returns a ValueToStringFormatter that renders nil values as nilStr.
+// All other values are rendered in the same manner as DefaultValueFormatter.
+//
+// DefaultValueFormatter renders nil values as "NaN", which makes missing values indistinguishable
+// from actual NaN values (eg. in a SeriesGeneric of float64). NilValueFormatter can be used to
+// separate the two concepts in the output.
+//
+// Example:
+//
+//  s.SetValueToStringFormatter(dataframe.NilValueFormatter(""))
+//
+func NilValueFormatter(nilStr string) ValueToStringFormatter {
+	return func(v interface{}) string {
+		if v == nil {
+			return nilStr
+		}
+		return DefaultValueFormatter(v)
+	}
+}
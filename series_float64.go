@@ -5,6 +5,7 @@ package dataframe
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"sync"
@@ -144,6 +145,9 @@ func (s *SeriesFloat64) Prepend(val interface{}, options ...Options) {
 		s.Values = s.Values[:len(s.Values)+1]
 		copy(s.Values[1:], s.Values)
 		s.Values[0] = s.valToPointer(val)
+		if isNaN(s.Values[0]) {
+			s.nilCount++
+		}
 		return
 	}
 
@@ -167,6 +171,22 @@ func (s *SeriesFloat64) Append(val interface{}, options ...Options) int {
 	return row
 }
 
+// AppendSlice is used to add many values to the end of the series
+// in a single operation. NaN values represent nil values.
+func (s *SeriesFloat64) AppendSlice(vals []float64, options ...Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	for _, v := range vals {
+		if isNaN(v) {
+			s.nilCount++
+		}
+	}
+	s.Values = append(s.Values, vals...)
+}
+
 // Insert is used to set a value at an arbitrary row in
 // the series. All existing values from that row onwards
 // are shifted by 1. val can be a concrete data type or nil.
@@ -218,6 +238,145 @@ func (s *SeriesFloat64) Remove(row int, options ...Options) {
 	s.Values = append(s.Values[:row], s.Values[row+1:]...)
 }
 
+// RemoveRows is used to delete the values of multiple rows in a single pass.
+// rows does not need to be sorted. An error is returned (without modifying the series)
+// if rows contains a duplicate or out of range row.
+func (s *SeriesFloat64) RemoveRows(rows []int, options ...Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	sorted, err := sortedRows(rows, len(s.Values))
+	if err != nil {
+		return err
+	}
+
+	var j, k int
+	for i, v := range s.Values {
+		if k < len(sorted) && sorted[k] == i {
+			if isNaN(v) {
+				s.nilCount--
+			}
+			k++
+			continue
+		}
+		s.Values[j] = v
+		j++
+	}
+
+	s.Values = s.Values[:j]
+
+	return nil
+}
+
+// DropNil removes all nil values from the series.
+// The number of values removed is returned.
+func (s *SeriesFloat64) DropNil(options ...Options) int {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	var j int
+	for _, v := range s.Values {
+		if isNaN(v) {
+			continue
+		}
+		s.Values[j] = v
+		j++
+	}
+
+	removed := len(s.Values) - j
+	s.Values = s.Values[:j]
+	s.nilCount = 0
+
+	return removed
+}
+
+// TrimCapacity will reallocate the underlying slice so that its capacity
+// matches the number of rows. Removing rows does not release the memory
+// held by the underlying slice, so this should be called after many rows
+// have been removed.
+func (s *SeriesFloat64) TrimCapacity(options ...Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	if cap(s.Values) == len(s.Values) {
+		return
+	}
+
+	newSlice := make([]float64, len(s.Values))
+	copy(newSlice, s.Values)
+	s.Values = newSlice
+}
+
+// Reserve will grow the capacity of the underlying slice so that at least
+// additional rows can be appended without further reallocation.
+// It is useful when the number of rows to be appended is known in advance.
+func (s *SeriesFloat64) Reserve(additional int, options ...Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	s.reserve(additional)
+}
+
+// reserve grows the capacity of the underlying slice. s must be locked before calling reserve.
+func (s *SeriesFloat64) reserve(additional int) {
+	if n := len(s.Values) + additional; n > cap(s.Values) {
+		newSlice := make([]float64, len(s.Values), n)
+		copy(newSlice, s.Values)
+		s.Values = newSlice
+	}
+}
+
+// Splice replaces the rows within r with vals. vals can be a []float64 or a single value.
+// The number of values inserted does not need to match the number of rows removed.
+// Subsequent rows are shifted accordingly.
+func (s *SeriesFloat64) Splice(r Range, vals interface{}, options ...Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	start, end, err := r.Limits(len(s.Values))
+	if err != nil {
+		return err
+	}
+
+	var newVals []float64
+	switch V := vals.(type) {
+	case []float64:
+		newVals = V
+	default:
+		newVals = []float64{s.valToPointer(vals)}
+	}
+
+	for _, v := range s.Values[start : end+1] {
+		if isNaN(v) {
+			s.nilCount--
+		}
+	}
+
+	for _, v := range newVals {
+		if isNaN(v) {
+			s.nilCount++
+		}
+	}
+
+	out := make([]float64, 0, len(s.Values)-(end-start+1)+len(newVals))
+	out = append(out, s.Values[:start]...)
+	out = append(out, newVals...)
+	out = append(out, s.Values[end+1:]...)
+	s.Values = out
+
+	return nil
+}
+
 // Update is used to update the value of a particular row.
 // val can be a concrete data type or nil. Nil represents
 // the absence of a value.
@@ -283,7 +442,22 @@ func (s *SeriesFloat64) Swap(row1, row2 int, options ...Options) {
 	s.Values[row1], s.Values[row2] = s.Values[row2], s.Values[row1]
 }
 
+// Reverse reverses the order of the rows in place.
+// See utils.Reverse for reversing a subset of rows.
+func (s *SeriesFloat64) Reverse(options ...Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	for i, j := 0, len(s.Values)-1; i < j; i, j = i+1, j-1 {
+		s.Values[i], s.Values[j] = s.Values[j], s.Values[i]
+	}
+}
+
 // IsEqualFunc returns true if a is equal to b.
+// Values must be exactly equal. See IsEqualApproxFunc for
+// comparing values that are the result of computations.
 func (s *SeriesFloat64) IsEqualFunc(a, b interface{}) bool {
 
 	if a == nil {
@@ -302,6 +476,27 @@ func (s *SeriesFloat64) IsEqualFunc(a, b interface{}) bool {
 	return f1 == f2
 }
 
+// IsEqualApproxFunc returns true if a is equal to b within
+// an absolute tolerance of epsilon. It is the same as IsEqualFunc
+// when epsilon is 0.
+func (s *SeriesFloat64) IsEqualApproxFunc(a, b interface{}, epsilon float64) bool {
+
+	if a == nil {
+		if b == nil {
+			return true
+		}
+		return false
+	}
+
+	if b == nil {
+		return false
+	}
+	f1 := a.(float64)
+	f2 := b.(float64)
+
+	return f1 == f2 || math.Abs(f1-f2) <= epsilon
+}
+
 // IsLessThanFunc returns true if a is less than b.
 func (s *SeriesFloat64) IsLessThanFunc(a, b interface{}) bool {
 
@@ -364,6 +559,52 @@ func (s *SeriesFloat64) Sort(options ...Options) {
 	})
 }
 
+// SortByKey reorders the series based on the sorted order of keys (eg. an external timestamp slice).
+// keys must contain the same number of values as the series. NaN keys are treated as nil.
+// The sort is stable. The SortDesc option is honored.
+func (s *SeriesFloat64) SortByKey(keys []float64, options ...Options) error {
+	return s.sortByKey(len(keys), float64KeyLess(keys), options...)
+}
+
+// SortByKeyInt64 is the same as SortByKey except keys are int64.
+func (s *SeriesFloat64) SortByKeyInt64(keys []int64, options ...Options) error {
+	return s.sortByKey(len(keys), int64KeyLess(keys), options...)
+}
+
+// SortByKeyGeneric is the same as SortByKey except keys can be of any type.
+// less is used to compare keys.
+func (s *SeriesFloat64) SortByKeyGeneric(keys []interface{}, less IsLessThanFunc, options ...Options) error {
+	return s.sortByKey(len(keys), genericKeyLess(keys, less), options...)
+}
+
+func (s *SeriesFloat64) sortByKey(n int, less func(i, j int) bool, options ...Options) error {
+
+	var sortDesc bool
+
+	if len(options) == 0 {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	} else {
+		if !options[0].DontLock {
+			s.lock.Lock()
+			defer s.lock.Unlock()
+		}
+		sortDesc = options[0].SortDesc
+	}
+
+	if n != len(s.Values) {
+		return ErrMismatchedRows
+	}
+
+	newVals := make([]float64, 0, len(s.Values))
+	for _, row := range keyOrder(n, less, sortDesc) {
+		newVals = append(newVals, s.Values[row])
+	}
+	s.Values = newVals
+
+	return nil
+}
+
 // Lock will lock the Series allowing you to directly manipulate
 // the underlying slice with confidence.
 func (s *SeriesFloat64) Lock() {
@@ -402,14 +643,43 @@ func (s *SeriesFloat64) Copy(r ...Range) Series {
 	x := s.Values[start : end+1]
 	newSlice := append(x[:0:0], x...)
 
+	var nilCount int
+	for _, v := range newSlice {
+		if isNaN(v) {
+			nilCount++
+		}
+	}
+
 	return &SeriesFloat64{
 		valFormatter: s.valFormatter,
 		name:         s.name,
 		Values:       newSlice,
-		nilCount:     s.nilCount,
+		nilCount:     nilCount,
 	}
 }
 
+// Head returns a copy of the first n rows.
+// If n is larger than the number of rows, all rows are returned.
+func (s *SeriesFloat64) Head(n int, options ...Options) Series {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	return headTail(s, n, false)
+}
+
+// Tail returns a copy of the last n rows.
+// If n is larger than the number of rows, all rows are returned.
+func (s *SeriesFloat64) Tail(n int, options ...Options) Series {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	return headTail(s, n, true)
+}
+
 // Table will produce the Series in a table.
 func (s *SeriesFloat64) Table(r ...Range) string {
 
@@ -487,3 +757,274 @@ func (s *SeriesFloat64) ContainsNil() bool {
 	defer s.lock.RUnlock()
 	return s.nilCount > 0
 }
+
+// IsNull returns a mask containing true for each row with a nil value and false otherwise.
+// The mask can be combined with other masks and used with FilterByMask.
+func (s *SeriesFloat64) IsNull(options ...Options) *SeriesBool {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	mask := NewSeriesBool(s.name, &SeriesInit{Capacity: len(s.Values)})
+	for _, v := range s.Values {
+		mask.Append(isNaN(v))
+	}
+
+	return mask
+}
+
+// Validate recomputes the number of nil values and compares it against the
+// cached count, returning an error if they differ. An error is also returned
+// if the series contains an Inf value.
+// It is intended to be used as a self-check while debugging and in tests.
+func (s *SeriesFloat64) Validate(options ...Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	var nilCount int
+	for row, v := range s.Values {
+		if isNaN(v) {
+			nilCount++
+		} else if math.IsInf(v, 0) {
+			return fmt.Errorf("row %d contains an Inf value", row)
+		}
+	}
+
+	if nilCount != s.nilCount {
+		return fmt.Errorf("nil count mismatch: cached: %d actual: %d", s.nilCount, nilCount)
+	}
+
+	return nil
+}
+
+// ForEach calls fn for each row of the series in order. val is nil for missing values.
+// Iteration stops as soon as fn returns an error, which is then returned by ForEach.
+// The series is read locked once for the duration of the iteration, so fn must not
+// modify the series.
+func (s *SeriesFloat64) ForEach(fn func(row int, val interface{}) error, options ...Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	for row := range s.Values {
+		if err := fn(row, s.Value(row, Options{true, false})); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValuesIterator will return an iterator that can be used to iterate through all the values.
+// The iterator returns the row, the value and the number of rows that are yet to be visited
+// (including rows that may be skipped due to SkipNil).
+//
+// Example:
+//
+//  iterator := s.ValuesIterator(dataframe.ValuesOptions{SkipNil: true})
+//  for {
+//     row, val, _ := iterator()
+//     if row == nil {
+//        break
+//     }
+//     fmt.Println(*row, val)
+//  }
+//
+func (s *SeriesFloat64) ValuesIterator(options ...ValuesOptions) func() (*int, interface{}, int) {
+
+	var skipNil bool
+	if len(options) > 0 {
+		skipNil = options[0].SkipNil
+	}
+
+	next := newIterator(s.lock.RLocker(), func() int { return len(s.Values) }, options...)
+
+	return func() (*int, interface{}, int) {
+		for {
+			row, remaining, unlock := next()
+			if row == nil {
+				return nil, nil, 0
+			}
+
+			val := s.Value(*row, DontLock)
+			unlock()
+
+			if skipNil && val == nil {
+				continue
+			}
+			return row, val, remaining
+		}
+	}
+}
+
+// NUnique returns the number of distinct non-nil values.
+// NaN values are treated as nil. Positive and negative zero are
+// considered the same value.
+func (s *SeriesFloat64) NUnique(options ...NUniqueOptions) int {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	var hasNil bool
+	unique := map[float64]struct{}{}
+	for _, v := range s.Values {
+		if isNaN(v) {
+			hasNil = true
+			continue
+		}
+		unique[v] = struct{}{}
+	}
+
+	if len(options) > 0 && options[0].IncludeNil && hasNil {
+		return len(unique) + 1
+	}
+	return len(unique)
+}
+
+// Unique returns the distinct non-nil values in the order they first appear.
+// NaN values are treated as nil.
+// If IncludeNil is set, nil is also returned (once) if the series contains nil values.
+func (s *SeriesFloat64) Unique(options ...NUniqueOptions) []interface{} {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	includeNil := len(options) > 0 && options[0].IncludeNil
+
+	var hasNil bool
+	out := []interface{}{}
+	seen := map[float64]struct{}{}
+	for _, v := range s.Values {
+		if isNaN(v) {
+			if includeNil && !hasNil {
+				out = append(out, nil)
+			}
+			hasNil = true
+			continue
+		}
+		if _, exists := seen[v]; !exists {
+			seen[v] = struct{}{}
+			out = append(out, v)
+		}
+	}
+
+	return out
+}
+
+// ValueCounts returns the number of occurrences of each distinct non-nil value.
+// NaN values are treated as nil.
+// If IncludeNil is set, the number of nil values is stored with a nil key.
+func (s *SeriesFloat64) ValueCounts(options ...NUniqueOptions) map[interface{}]int {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	out := map[interface{}]int{}
+	for _, v := range s.Values {
+		if isNaN(v) {
+			if len(options) > 0 && options[0].IncludeNil {
+				out[nil]++
+			}
+			continue
+		}
+		out[v]++
+	}
+
+	return out
+}
+
+// TreatSmallAsNil converts all values with an absolute value less than epsilon to nil.
+// It is useful for cleaning noisy data where near-zero values represent missing data.
+// The number of values converted is returned.
+func (s *SeriesFloat64) TreatSmallAsNil(epsilon float64, options ...Options) int {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	var count int
+	for i, v := range s.Values {
+		if !isNaN(v) && math.Abs(v) < epsilon {
+			s.Values[i] = nan()
+			count++
+		}
+	}
+	s.nilCount = s.nilCount + count
+
+	return count
+}
+
+// CombineFirst returns a new series containing the values of s. Where a value of s is nil,
+// the corresponding value of other is used instead. It is useful for filling the gaps in
+// one data source from a secondary data source.
+// other must contain the same number of rows as s.
+func (s *SeriesFloat64) CombineFirst(other *SeriesFloat64, options ...Options) (*SeriesFloat64, error) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+		if other != s {
+			other.lock.RLock()
+			defer other.lock.RUnlock()
+		}
+	}
+
+	if len(other.Values) != len(s.Values) {
+		return nil, ErrMismatchedRows
+	}
+
+	ns := NewSeriesFloat64(s.name, &SeriesInit{Capacity: len(s.Values)})
+	for i, v := range s.Values {
+		if isNaN(v) {
+			v = other.Values[i]
+		}
+		ns.Values = append(ns.Values, v)
+		if isNaN(v) {
+			ns.nilCount++
+		}
+	}
+
+	return ns, nil
+}
+
+// SetWhere updates the values of s with the corresponding values of other where mask is true.
+// A nil value in mask is treated as false.
+// mask and other must contain the same number of rows as s.
+func (s *SeriesFloat64) SetWhere(mask *SeriesBool, other *SeriesFloat64, options ...Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		if other != s {
+			other.lock.RLock()
+			defer other.lock.RUnlock()
+		}
+		mask.lock.RLock()
+		defer mask.lock.RUnlock()
+	}
+
+	if len(mask.values) != len(s.Values) || len(other.Values) != len(s.Values) {
+		return ErrMismatchedRows
+	}
+
+	for i, m := range mask.values {
+		if m == nil || !*m {
+			continue
+		}
+
+		newVal := other.Values[i]
+		if isNaN(s.Values[i]) && !isNaN(newVal) {
+			s.nilCount--
+		} else if !isNaN(s.Values[i]) && isNaN(newVal) {
+			s.nilCount++
+		}
+		s.Values[i] = newVal
+	}
+
+	return nil
+}
@@ -13,7 +13,7 @@ import (
 )
 
 // SeriesGeneric is a series of data where the contained data can be
-// of any type. Only concrete data types can be used.
+// of any type. Only concrete data types and slices can be used.
 type SeriesGeneric struct {
 	valFormatter   ValueToStringFormatter
 	isEqualFunc    IsEqualFunc
@@ -156,6 +156,7 @@ func (s *SeriesGeneric) Prepend(val interface{}, options ...Options) {
 		copy(s.values[1:], s.values)
 		if val == nil {
 			s.values[0] = nil
+			s.nilCount++
 		} else {
 			if err := s.checkValue(val); err != nil {
 				panic(err)
@@ -227,6 +228,62 @@ func (s *SeriesGeneric) Remove(row int, options ...Options) {
 	s.values = append(s.values[:row], s.values[row+1:]...)
 }
 
+// RemoveRows is used to delete the values of multiple rows in a single pass.
+// rows does not need to be sorted. An error is returned (without modifying the series)
+// if rows contains a duplicate or out of range row.
+func (s *SeriesGeneric) RemoveRows(rows []int, options ...Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	sorted, err := sortedRows(rows, len(s.values))
+	if err != nil {
+		return err
+	}
+
+	var j, k int
+	for i, v := range s.values {
+		if k < len(sorted) && sorted[k] == i {
+			if v == nil {
+				s.nilCount--
+			}
+			k++
+			continue
+		}
+		s.values[j] = v
+		j++
+	}
+
+	// Release references held by the unused portion of the underlying slice
+	for i := j; i < len(s.values); i++ {
+		s.values[i] = nil
+	}
+
+	s.values = s.values[:j]
+
+	return nil
+}
+
+// TrimCapacity will reallocate the underlying slice so that its capacity
+// matches the number of rows. Removing rows does not release the memory
+// held by the underlying slice, so this should be called after many rows
+// have been removed.
+func (s *SeriesGeneric) TrimCapacity(options ...Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	if cap(s.values) == len(s.values) {
+		return
+	}
+
+	newSlice := make([]interface{}, len(s.values))
+	copy(newSlice, s.values)
+	s.values = newSlice
+}
+
 // Update is used to update the value of a particular row.
 // val can be a concrete data type or nil. Nil represents
 // the absence of a value.
@@ -352,6 +409,52 @@ func (s *SeriesGeneric) Sort(options ...Options) {
 	})
 }
 
+// SortByKey reorders the series based on the sorted order of keys (eg. an external timestamp slice).
+// keys must contain the same number of values as the series. NaN keys are treated as nil.
+// The sort is stable. The SortDesc option is honored.
+func (s *SeriesGeneric) SortByKey(keys []float64, options ...Options) error {
+	return s.sortByKey(len(keys), float64KeyLess(keys), options...)
+}
+
+// SortByKeyInt64 is the same as SortByKey except keys are int64.
+func (s *SeriesGeneric) SortByKeyInt64(keys []int64, options ...Options) error {
+	return s.sortByKey(len(keys), int64KeyLess(keys), options...)
+}
+
+// SortByKeyGeneric is the same as SortByKey except keys can be of any type.
+// less is used to compare keys.
+func (s *SeriesGeneric) SortByKeyGeneric(keys []interface{}, less IsLessThanFunc, options ...Options) error {
+	return s.sortByKey(len(keys), genericKeyLess(keys, less), options...)
+}
+
+func (s *SeriesGeneric) sortByKey(n int, less func(i, j int) bool, options ...Options) error {
+
+	var sortDesc bool
+
+	if len(options) == 0 {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	} else {
+		if !options[0].DontLock {
+			s.lock.Lock()
+			defer s.lock.Unlock()
+		}
+		sortDesc = options[0].SortDesc
+	}
+
+	if n != len(s.values) {
+		return ErrMismatchedRows
+	}
+
+	newVals := make([]interface{}, 0, len(s.values))
+	for _, row := range keyOrder(n, less, sortDesc) {
+		newVals = append(newVals, s.values[row])
+	}
+	s.values = newVals
+
+	return nil
+}
+
 // Swap is used to swap 2 values based on their row position.
 func (s *SeriesGeneric) Swap(row1, row2 int, options ...Options) {
 	if row1 == row2 {
@@ -409,6 +512,13 @@ func (s *SeriesGeneric) Copy(r ...Range) Series {
 	x := s.values[start : end+1]
 	newSlice := append(x[:0:0], x...)
 
+	var nilCount int
+	for _, v := range newSlice {
+		if v == nil {
+			nilCount++
+		}
+	}
+
 	return &SeriesGeneric{
 		valFormatter:   s.valFormatter,
 		isEqualFunc:    s.isEqualFunc,
@@ -418,8 +528,30 @@ func (s *SeriesGeneric) Copy(r ...Range) Series {
 
 		name:     s.name,
 		values:   newSlice,
-		nilCount: s.nilCount,
+		nilCount: nilCount,
+	}
+}
+
+// Head returns a copy of the first n rows.
+// If n is larger than the number of rows, all rows are returned.
+func (s *SeriesGeneric) Head(n int, options ...Options) Series {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	return headTail(s, n, false)
+}
+
+// Tail returns a copy of the last n rows.
+// If n is larger than the number of rows, all rows are returned.
+func (s *SeriesGeneric) Tail(n int, options ...Options) Series {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
 	}
+
+	return headTail(s, n, true)
 }
 
 // Table will produce the Series in a table.
@@ -498,3 +630,61 @@ func (s *SeriesGeneric) ContainsNil() bool {
 	defer s.lock.RUnlock()
 	return s.nilCount > 0
 }
+
+// IsNull returns a mask containing true for each row with a nil value and false otherwise.
+// The mask can be combined with other masks and used with FilterByMask.
+func (s *SeriesGeneric) IsNull(options ...Options) *SeriesBool {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	mask := NewSeriesBool(s.name, &SeriesInit{Capacity: len(s.values)})
+	for _, v := range s.values {
+		mask.Append(v == nil)
+	}
+
+	return mask
+}
+
+// Validate recomputes the number of nil values and compares it against the
+// cached count, returning an error if they differ.
+// It is intended to be used as a self-check while debugging and in tests.
+func (s *SeriesGeneric) Validate(options ...Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	var nilCount int
+	for _, v := range s.values {
+		if v == nil {
+			nilCount++
+		}
+	}
+
+	if nilCount != s.nilCount {
+		return fmt.Errorf("nil count mismatch: cached: %d actual: %d", s.nilCount, nilCount)
+	}
+
+	return nil
+}
+
+// ForEach calls fn for each row of the series in order. val is nil for missing values.
+// Iteration stops as soon as fn returns an error, which is then returned by ForEach.
+// The series is read locked once for the duration of the iteration, so fn must not
+// modify the series.
+func (s *SeriesGeneric) ForEach(fn func(row int, val interface{}) error, options ...Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	for row := range s.values {
+		if err := fn(row, s.Value(row, Options{true, false})); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
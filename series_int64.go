@@ -138,6 +138,9 @@ func (s *SeriesInt64) Prepend(val interface{}, options ...Options) {
 		s.values = s.values[:len(s.values)+1]
 		copy(s.values[1:], s.values)
 		s.values[0] = s.valToPointer(val)
+		if s.values[0] == nil {
+			s.nilCount++
+		}
 		return
 	}
 
@@ -161,6 +164,25 @@ func (s *SeriesInt64) Append(val interface{}, options ...Options) int {
 	return row
 }
 
+// AppendSlice is used to add many values to the end of the series
+// in a single operation.
+func (s *SeriesInt64) AppendSlice(vals []int64, options ...Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	s.reserve(len(vals))
+
+	// Store the values in a single allocation
+	backing := make([]int64, len(vals))
+	copy(backing, vals)
+
+	for i := range backing {
+		s.values = append(s.values, &backing[i])
+	}
+}
+
 // Insert is used to set a value at an arbitrary row in
 // the series. All existing values from that row onwards
 // are shifted by 1. val can be a concrete data type or nil.
@@ -202,7 +224,7 @@ func (s *SeriesInt64) insert(row int, val interface{}) {
 		s.nilCount++
 	}
 
-	s.values[row] = s.valToPointer(v)
+	s.values[row] = v
 }
 
 // Remove is used to delete the value of a particular row.
@@ -219,6 +241,132 @@ func (s *SeriesInt64) Remove(row int, options ...Options) {
 	s.values = append(s.values[:row], s.values[row+1:]...)
 }
 
+// RemoveRows is used to delete the values of multiple rows in a single pass.
+// rows does not need to be sorted. An error is returned (without modifying the series)
+// if rows contains a duplicate or out of range row.
+func (s *SeriesInt64) RemoveRows(rows []int, options ...Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	sorted, err := sortedRows(rows, len(s.values))
+	if err != nil {
+		return err
+	}
+
+	var j, k int
+	for i, v := range s.values {
+		if k < len(sorted) && sorted[k] == i {
+			if v == nil {
+				s.nilCount--
+			}
+			k++
+			continue
+		}
+		s.values[j] = v
+		j++
+	}
+
+	// Release references held by the unused portion of the underlying slice
+	for i := j; i < len(s.values); i++ {
+		s.values[i] = nil
+	}
+
+	s.values = s.values[:j]
+
+	return nil
+}
+
+// TrimCapacity will reallocate the underlying slice so that its capacity
+// matches the number of rows. Removing rows does not release the memory
+// held by the underlying slice, so this should be called after many rows
+// have been removed.
+func (s *SeriesInt64) TrimCapacity(options ...Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	if cap(s.values) == len(s.values) {
+		return
+	}
+
+	newSlice := make([]*int64, len(s.values))
+	copy(newSlice, s.values)
+	s.values = newSlice
+}
+
+// Reserve will grow the capacity of the underlying slice so that at least
+// additional rows can be appended without further reallocation.
+// It is useful when the number of rows to be appended is known in advance.
+func (s *SeriesInt64) Reserve(additional int, options ...Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	s.reserve(additional)
+}
+
+// reserve grows the capacity of the underlying slice. s must be locked before calling reserve.
+func (s *SeriesInt64) reserve(additional int) {
+	if n := len(s.values) + additional; n > cap(s.values) {
+		newSlice := make([]*int64, len(s.values), n)
+		copy(newSlice, s.values)
+		s.values = newSlice
+	}
+}
+
+// Splice replaces the rows within r with vals. vals can be a []int64, []*int64 or a single value.
+// The number of values inserted does not need to match the number of rows removed.
+// Subsequent rows are shifted accordingly.
+func (s *SeriesInt64) Splice(r Range, vals interface{}, options ...Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	start, end, err := r.Limits(len(s.values))
+	if err != nil {
+		return err
+	}
+
+	var newVals []*int64
+	switch V := vals.(type) {
+	case []int64:
+		for _, v := range V {
+			newVals = append(newVals, s.valToPointer(v))
+		}
+	case []*int64:
+		for _, v := range V {
+			newVals = append(newVals, s.valToPointer(v))
+		}
+	default:
+		newVals = []*int64{s.valToPointer(vals)}
+	}
+
+	for _, v := range s.values[start : end+1] {
+		if v == nil {
+			s.nilCount--
+		}
+	}
+
+	for _, v := range newVals {
+		if v == nil {
+			s.nilCount++
+		}
+	}
+
+	out := make([]*int64, 0, len(s.values)-(end-start+1)+len(newVals))
+	out = append(out, s.values[:start]...)
+	out = append(out, newVals...)
+	out = append(out, s.values[end+1:]...)
+	s.values = out
+
+	return nil
+}
+
 // Update is used to update the value of a particular row.
 // val can be a concrete data type or nil. Nil represents
 // the absence of a value.
@@ -284,6 +432,19 @@ func (s *SeriesInt64) Swap(row1, row2 int, options ...Options) {
 	s.values[row1], s.values[row2] = s.values[row2], s.values[row1]
 }
 
+// Reverse reverses the order of the rows in place.
+// See utils.Reverse for reversing a subset of rows.
+func (s *SeriesInt64) Reverse(options ...Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	for i, j := 0, len(s.values)-1; i < j; i, j = i+1, j-1 {
+		s.values[i], s.values[j] = s.values[j], s.values[i]
+	}
+}
+
 // IsEqualFunc returns true if a is equal to b.
 func (s *SeriesInt64) IsEqualFunc(a, b interface{}) bool {
 
@@ -365,6 +526,52 @@ func (s *SeriesInt64) Sort(options ...Options) {
 	})
 }
 
+// SortByKey reorders the series based on the sorted order of keys (eg. an external timestamp slice).
+// keys must contain the same number of values as the series. NaN keys are treated as nil.
+// The sort is stable. The SortDesc option is honored.
+func (s *SeriesInt64) SortByKey(keys []float64, options ...Options) error {
+	return s.sortByKey(len(keys), float64KeyLess(keys), options...)
+}
+
+// SortByKeyInt64 is the same as SortByKey except keys are int64.
+func (s *SeriesInt64) SortByKeyInt64(keys []int64, options ...Options) error {
+	return s.sortByKey(len(keys), int64KeyLess(keys), options...)
+}
+
+// SortByKeyGeneric is the same as SortByKey except keys can be of any type.
+// less is used to compare keys.
+func (s *SeriesInt64) SortByKeyGeneric(keys []interface{}, less IsLessThanFunc, options ...Options) error {
+	return s.sortByKey(len(keys), genericKeyLess(keys, less), options...)
+}
+
+func (s *SeriesInt64) sortByKey(n int, less func(i, j int) bool, options ...Options) error {
+
+	var sortDesc bool
+
+	if len(options) == 0 {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	} else {
+		if !options[0].DontLock {
+			s.lock.Lock()
+			defer s.lock.Unlock()
+		}
+		sortDesc = options[0].SortDesc
+	}
+
+	if n != len(s.values) {
+		return ErrMismatchedRows
+	}
+
+	newVals := make([]*int64, 0, len(s.values))
+	for _, row := range keyOrder(n, less, sortDesc) {
+		newVals = append(newVals, s.values[row])
+	}
+	s.values = newVals
+
+	return nil
+}
+
 // Lock will lock the Series allowing you to directly manipulate
 // the underlying slice with confidence.
 func (s *SeriesInt64) Lock() {
@@ -403,12 +610,41 @@ func (s *SeriesInt64) Copy(r ...Range) Series {
 	x := s.values[start : end+1]
 	newSlice := append(x[:0:0], x...)
 
+	var nilCount int
+	for _, v := range newSlice {
+		if v == nil {
+			nilCount++
+		}
+	}
+
 	return &SeriesInt64{
 		valFormatter: s.valFormatter,
 		name:         s.name,
 		values:       newSlice,
-		nilCount:     s.nilCount,
+		nilCount:     nilCount,
+	}
+}
+
+// Head returns a copy of the first n rows.
+// If n is larger than the number of rows, all rows are returned.
+func (s *SeriesInt64) Head(n int, options ...Options) Series {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
 	}
+
+	return headTail(s, n, false)
+}
+
+// Tail returns a copy of the last n rows.
+// If n is larger than the number of rows, all rows are returned.
+func (s *SeriesInt64) Tail(n int, options ...Options) Series {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	return headTail(s, n, true)
 }
 
 // Table will produce the Series in a table.
@@ -487,3 +723,178 @@ func (s *SeriesInt64) ContainsNil() bool {
 	defer s.lock.RUnlock()
 	return s.nilCount > 0
 }
+
+// IsNull returns a mask containing true for each row with a nil value and false otherwise.
+// The mask can be combined with other masks and used with FilterByMask.
+func (s *SeriesInt64) IsNull(options ...Options) *SeriesBool {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	mask := NewSeriesBool(s.name, &SeriesInit{Capacity: len(s.values)})
+	for _, v := range s.values {
+		mask.Append(v == nil)
+	}
+
+	return mask
+}
+
+// Validate recomputes the number of nil values and compares it against the
+// cached count, returning an error if they differ.
+// It is intended to be used as a self-check while debugging and in tests.
+func (s *SeriesInt64) Validate(options ...Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	var nilCount int
+	for _, v := range s.values {
+		if v == nil {
+			nilCount++
+		}
+	}
+
+	if nilCount != s.nilCount {
+		return fmt.Errorf("nil count mismatch: cached: %d actual: %d", s.nilCount, nilCount)
+	}
+
+	return nil
+}
+
+// ForEach calls fn for each row of the series in order. val is nil for missing values.
+// Iteration stops as soon as fn returns an error, which is then returned by ForEach.
+// The series is read locked once for the duration of the iteration, so fn must not
+// modify the series.
+func (s *SeriesInt64) ForEach(fn func(row int, val interface{}) error, options ...Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	for row := range s.values {
+		if err := fn(row, s.Value(row, Options{true, false})); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValuesIterator will return an iterator that can be used to iterate through all the values.
+// The iterator returns the row, the value and the number of rows that are yet to be visited
+// (including rows that may be skipped due to SkipNil).
+//
+// Example:
+//
+//  iterator := s.ValuesIterator(dataframe.ValuesOptions{SkipNil: true})
+//  for {
+//     row, val, _ := iterator()
+//     if row == nil {
+//        break
+//     }
+//     fmt.Println(*row, val)
+//  }
+//
+func (s *SeriesInt64) ValuesIterator(options ...ValuesOptions) func() (*int, interface{}, int) {
+
+	var skipNil bool
+	if len(options) > 0 {
+		skipNil = options[0].SkipNil
+	}
+
+	next := newIterator(s.lock.RLocker(), func() int { return len(s.values) }, options...)
+
+	return func() (*int, interface{}, int) {
+		for {
+			row, remaining, unlock := next()
+			if row == nil {
+				return nil, nil, 0
+			}
+
+			val := s.Value(*row, DontLock)
+			unlock()
+
+			if skipNil && val == nil {
+				continue
+			}
+			return row, val, remaining
+		}
+	}
+}
+
+// NUnique returns the number of distinct non-nil values.
+func (s *SeriesInt64) NUnique(options ...NUniqueOptions) int {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	var hasNil bool
+	unique := map[int64]struct{}{}
+	for _, v := range s.values {
+		if v == nil {
+			hasNil = true
+			continue
+		}
+		unique[*v] = struct{}{}
+	}
+
+	if len(options) > 0 && options[0].IncludeNil && hasNil {
+		return len(unique) + 1
+	}
+	return len(unique)
+}
+
+// Unique returns the distinct non-nil values in the order they first appear.
+// If IncludeNil is set, nil is also returned (once) if the series contains nil values.
+func (s *SeriesInt64) Unique(options ...NUniqueOptions) []interface{} {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	includeNil := len(options) > 0 && options[0].IncludeNil
+
+	var hasNil bool
+	out := []interface{}{}
+	seen := map[int64]struct{}{}
+	for _, v := range s.values {
+		if v == nil {
+			if includeNil && !hasNil {
+				out = append(out, nil)
+			}
+			hasNil = true
+			continue
+		}
+		if _, exists := seen[*v]; !exists {
+			seen[*v] = struct{}{}
+			out = append(out, *v)
+		}
+	}
+
+	return out
+}
+
+// ValueCounts returns the number of occurrences of each distinct non-nil value.
+// If IncludeNil is set, the number of nil values is stored with a nil key.
+func (s *SeriesInt64) ValueCounts(options ...NUniqueOptions) map[interface{}]int {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	out := map[interface{}]int{}
+	for _, v := range s.values {
+		if v == nil {
+			if len(options) > 0 && options[0].IncludeNil {
+				out[nil]++
+			}
+			continue
+		}
+		out[*v]++
+	}
+
+	return out
+}
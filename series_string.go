@@ -113,6 +113,21 @@ func (s *SeriesString) Value(row int, options ...Options) interface{} {
 	return *val
 }
 
+// StringAt returns the value of a particular row without boxing it in an interface{}.
+// ok is false if the value is nil, in which case the zero value is returned.
+func (s *SeriesString) StringAt(row int, options ...Options) (val string, ok bool) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	v := s.values[row]
+	if v == nil {
+		return "", false
+	}
+	return *v, true
+}
+
 // ValueString returns a string representation of a
 // particular row. The string representation is defined
 // by the function set in SetValueToStringFormatter.
@@ -137,6 +152,9 @@ func (s *SeriesString) Prepend(val interface{}, options ...Options) {
 		s.values = s.values[:len(s.values)+1]
 		copy(s.values[1:], s.values)
 		s.values[0] = s.valToPointer(val)
+		if s.values[0] == nil {
+			s.nilCount++
+		}
 		return
 	}
 
@@ -217,6 +235,62 @@ func (s *SeriesString) Remove(row int, options ...Options) {
 	s.values = append(s.values[:row], s.values[row+1:]...)
 }
 
+// RemoveRows is used to delete the values of multiple rows in a single pass.
+// rows does not need to be sorted. An error is returned (without modifying the series)
+// if rows contains a duplicate or out of range row.
+func (s *SeriesString) RemoveRows(rows []int, options ...Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	sorted, err := sortedRows(rows, len(s.values))
+	if err != nil {
+		return err
+	}
+
+	var j, k int
+	for i, v := range s.values {
+		if k < len(sorted) && sorted[k] == i {
+			if v == nil {
+				s.nilCount--
+			}
+			k++
+			continue
+		}
+		s.values[j] = v
+		j++
+	}
+
+	// Release references held by the unused portion of the underlying slice
+	for i := j; i < len(s.values); i++ {
+		s.values[i] = nil
+	}
+
+	s.values = s.values[:j]
+
+	return nil
+}
+
+// TrimCapacity will reallocate the underlying slice so that its capacity
+// matches the number of rows. Removing rows does not release the memory
+// held by the underlying slice, so this should be called after many rows
+// have been removed.
+func (s *SeriesString) TrimCapacity(options ...Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	if cap(s.values) == len(s.values) {
+		return
+	}
+
+	newSlice := make([]*string, len(s.values))
+	copy(newSlice, s.values)
+	s.values = newSlice
+}
+
 // Update is used to update the value of a particular row.
 // val can be a concrete data type or nil. Nil represents
 // the absence of a value.
@@ -363,6 +437,52 @@ func (s *SeriesString) Sort(options ...Options) {
 	})
 }
 
+// SortByKey reorders the series based on the sorted order of keys (eg. an external timestamp slice).
+// keys must contain the same number of values as the series. NaN keys are treated as nil.
+// The sort is stable. The SortDesc option is honored.
+func (s *SeriesString) SortByKey(keys []float64, options ...Options) error {
+	return s.sortByKey(len(keys), float64KeyLess(keys), options...)
+}
+
+// SortByKeyInt64 is the same as SortByKey except keys are int64.
+func (s *SeriesString) SortByKeyInt64(keys []int64, options ...Options) error {
+	return s.sortByKey(len(keys), int64KeyLess(keys), options...)
+}
+
+// SortByKeyGeneric is the same as SortByKey except keys can be of any type.
+// less is used to compare keys.
+func (s *SeriesString) SortByKeyGeneric(keys []interface{}, less IsLessThanFunc, options ...Options) error {
+	return s.sortByKey(len(keys), genericKeyLess(keys, less), options...)
+}
+
+func (s *SeriesString) sortByKey(n int, less func(i, j int) bool, options ...Options) error {
+
+	var sortDesc bool
+
+	if len(options) == 0 {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	} else {
+		if !options[0].DontLock {
+			s.lock.Lock()
+			defer s.lock.Unlock()
+		}
+		sortDesc = options[0].SortDesc
+	}
+
+	if n != len(s.values) {
+		return ErrMismatchedRows
+	}
+
+	newVals := make([]*string, 0, len(s.values))
+	for _, row := range keyOrder(n, less, sortDesc) {
+		newVals = append(newVals, s.values[row])
+	}
+	s.values = newVals
+
+	return nil
+}
+
 // Lock will lock the Series allowing you to directly manipulate
 // the underlying slice with confidence.
 func (s *SeriesString) Lock() {
@@ -401,12 +521,41 @@ func (s *SeriesString) Copy(r ...Range) Series {
 	x := s.values[start : end+1]
 	newSlice := append(x[:0:0], x...)
 
+	var nilCount int
+	for _, v := range newSlice {
+		if v == nil {
+			nilCount++
+		}
+	}
+
 	return &SeriesString{
 		valFormatter: s.valFormatter,
 		name:         s.name,
 		values:       newSlice,
-		nilCount:     s.nilCount,
+		nilCount:     nilCount,
+	}
+}
+
+// Head returns a copy of the first n rows.
+// If n is larger than the number of rows, all rows are returned.
+func (s *SeriesString) Head(n int, options ...Options) Series {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
 	}
+
+	return headTail(s, n, false)
+}
+
+// Tail returns a copy of the last n rows.
+// If n is larger than the number of rows, all rows are returned.
+func (s *SeriesString) Tail(n int, options ...Options) Series {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	return headTail(s, n, true)
 }
 
 // Table will produce the Series in a table.
@@ -485,3 +634,136 @@ func (s *SeriesString) ContainsNil() bool {
 	defer s.lock.RUnlock()
 	return s.nilCount > 0
 }
+
+// IsNull returns a mask containing true for each row with a nil value and false otherwise.
+// The mask can be combined with other masks and used with FilterByMask.
+func (s *SeriesString) IsNull(options ...Options) *SeriesBool {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	mask := NewSeriesBool(s.name, &SeriesInit{Capacity: len(s.values)})
+	for _, v := range s.values {
+		mask.Append(v == nil)
+	}
+
+	return mask
+}
+
+// Validate recomputes the number of nil values and compares it against the
+// cached count, returning an error if they differ.
+// It is intended to be used as a self-check while debugging and in tests.
+func (s *SeriesString) Validate(options ...Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	var nilCount int
+	for _, v := range s.values {
+		if v == nil {
+			nilCount++
+		}
+	}
+
+	if nilCount != s.nilCount {
+		return fmt.Errorf("nil count mismatch: cached: %d actual: %d", s.nilCount, nilCount)
+	}
+
+	return nil
+}
+
+// ForEach calls fn for each row of the series in order. val is nil for missing values.
+// Iteration stops as soon as fn returns an error, which is then returned by ForEach.
+// The series is read locked once for the duration of the iteration, so fn must not
+// modify the series.
+func (s *SeriesString) ForEach(fn func(row int, val interface{}) error, options ...Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	for row := range s.values {
+		if err := fn(row, s.Value(row, Options{true, false})); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NUnique returns the number of distinct non-nil values.
+func (s *SeriesString) NUnique(options ...NUniqueOptions) int {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	var hasNil bool
+	unique := map[string]struct{}{}
+	for _, v := range s.values {
+		if v == nil {
+			hasNil = true
+			continue
+		}
+		unique[*v] = struct{}{}
+	}
+
+	if len(options) > 0 && options[0].IncludeNil && hasNil {
+		return len(unique) + 1
+	}
+	return len(unique)
+}
+
+// Unique returns the distinct non-nil values in the order they first appear.
+// If IncludeNil is set, nil is also returned (once) if the series contains nil values.
+func (s *SeriesString) Unique(options ...NUniqueOptions) []interface{} {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	includeNil := len(options) > 0 && options[0].IncludeNil
+
+	var hasNil bool
+	out := []interface{}{}
+	seen := map[string]struct{}{}
+	for _, v := range s.values {
+		if v == nil {
+			if includeNil && !hasNil {
+				out = append(out, nil)
+			}
+			hasNil = true
+			continue
+		}
+		if _, exists := seen[*v]; !exists {
+			seen[*v] = struct{}{}
+			out = append(out, *v)
+		}
+	}
+
+	return out
+}
+
+// ValueCounts returns the number of occurrences of each distinct non-nil value.
+// If IncludeNil is set, the number of nil values is stored with a nil key.
+func (s *SeriesString) ValueCounts(options ...NUniqueOptions) map[interface{}]int {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	out := map[interface{}]int{}
+	for _, v := range s.values {
+		if v == nil {
+			if len(options) > 0 && options[0].IncludeNil {
+				out[nil]++
+			}
+			continue
+		}
+		out[*v]++
+	}
+
+	return out
+}
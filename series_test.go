@@ -3,7 +3,9 @@
 package dataframe
 
 import (
+	"context"
 	"fmt"
+	"math"
 	"strings"
 	"testing"
 	"time"
@@ -146,6 +148,9 @@ func TestSeriesOperations(t *testing.T) {
 		for row := 0; row < len(exVals); row++ {
 			rowVal := s.ValueString(row)
 			exp := exVals[row]
+			if tm, ok := exp.(time.Time); ok {
+				exp = tm.Format(time.RFC3339)
+			}
 
 			if rowVal != fmt.Sprintf("%v", exp) {
 				t.Errorf("wrong val: expected: %v actual: %v", exp, rowVal)
@@ -201,6 +206,9 @@ func TestSeriesUpdate(t *testing.T) {
 		for row := 0; row < len(exVals); row++ {
 			rowVal := s.ValueString(row)
 			exp := exVals[row]
+			if tm, ok := exp.(time.Time); ok {
+				exp = tm.Format(time.RFC3339)
+			}
 
 			if rowVal != fmt.Sprintf("%v", exp) {
 				t.Errorf("wrong val: expected: %v actual: %v", exp, rowVal)
@@ -242,6 +250,9 @@ func TestSeriesSwap(t *testing.T) {
 		for row := 0; row < len(exVals); row++ {
 			rowVal := s.ValueString(row)
 			exp := exVals[row]
+			if tm, ok := exp.(time.Time); ok {
+				exp = tm.Format(time.RFC3339)
+			}
 
 			if rowVal != fmt.Sprintf("%v", exp) {
 				t.Errorf("wrong val: expected: %v actual: %v", exp, rowVal)
@@ -294,6 +305,9 @@ func TestSeriesSort(t *testing.T) {
 		for row := 0; row < len(exVals); row++ {
 			rowVal := s.ValueString(row)
 			exp := exVals[row]
+			if tm, ok := exp.(time.Time); ok {
+				exp = tm.Format(time.RFC3339)
+			}
 
 			if rowVal != fmt.Sprintf("%v", exp) {
 				t.Errorf("wrong val: expected: %v actual: %v", exp, rowVal)
@@ -349,15 +363,15 @@ func TestSeriesTable(t *testing.T) {
 +-----+--------+
 | 3X1 | STRING |
 +-----+--------+`,
-		`+-----+-------------------------------+
-|     |             TEST              |
-+-----+-------------------------------+
-| 0:  | 2017-01-01 05:30:12 +0000 UTC |
-| 1:  | 2017-01-02 05:30:12 +0000 UTC |
-| 2:  | 2017-01-03 05:30:12 +0000 UTC |
-+-----+-------------------------------+
-| 3X1 |             TIME              |
-+-----+-------------------------------+`,
+		`+-----+----------------------+
+|     |         TEST         |
++-----+----------------------+
+| 0:  | 2017-01-01T05:30:12Z |
+| 1:  | 2017-01-02T05:30:12Z |
+| 2:  | 2017-01-03T05:30:12Z |
++-----+----------------------+
+| 3X1 |         TIME         |
++-----+----------------------+`,
 		`+-----+------------+
 |     |    TEST    |
 +-----+------------+
@@ -404,12 +418,12 @@ func TestSeriesString(t *testing.T) {
 	expected := []string{`[ 1 2 3 ]`,
 		`[ 1 2 3 ]`,
 		`[ 1 2 3 ]`,
-		`[ 2017-01-01 05:30:12 +0000 UTC 2017-01-02 05:30:12 +0000 UTC 2017-01-03 05:30:12 +0000 UTC ]`,
+		`[ 2017-01-01T05:30:12Z 2017-01-02T05:30:12Z 2017-01-03T05:30:12Z ]`,
 		`[ 2018-05-01 2018-05-02 2018-05-03 ]`,
 		`[ 1 2 3 ... 5 6 7 ]`,
 		`[ 1 2 3 ... 5 6 7 ]`,
 		`[ 1 2 3 ... 5 6 7 ]`,
-		`[ 2017-01-01 05:30:12 +0000 UTC 2017-01-02 05:30:12 +0000 UTC 2017-01-03 05:30:12 +0000 UTC ... 2017-01-05 05:30:12 +0000 UTC 2017-01-06 05:30:12 +0000 UTC 2017-01-07 05:30:12 +0000 UTC ]`,
+		`[ 2017-01-01T05:30:12Z 2017-01-02T05:30:12Z 2017-01-03T05:30:12Z ... 2017-01-05T05:30:12Z 2017-01-06T05:30:12Z 2017-01-07T05:30:12Z ]`,
 		`[ 2018-05-01 2018-05-02 2018-05-03 ... 2018-05-05 2018-05-06 2018-05-07 ]`,
 	}
 
@@ -448,3 +462,1820 @@ func TestSeriesCopy(t *testing.T) {
 	}
 
 }
+
+func TestSeriesTrimCapacity(t *testing.T) {
+
+	// Create new series
+	init := []Series{
+		NewSeriesFloat64("test", &SeriesInit{0, 10}, 1.0, 2.0, 3.0, 4.0, 5.0),
+		NewSeriesInt64("test", &SeriesInit{0, 10}, 1, 2, 3, 4, 5),
+		NewSeriesString("test", &SeriesInit{0, 10}, "1", "2", "3", "4", "5"),
+		NewSeriesTime("test", &SeriesInit{0, 10}, time.Now(), time.Now(), time.Now(), time.Now(), time.Now()),
+		NewSeriesGeneric("test", civil.Date{}, &SeriesInit{0, 10}, civil.Date{2018, time.May, 01}, civil.Date{2018, time.May, 02}, civil.Date{2018, time.May, 03}, civil.Date{2018, time.May, 04}, civil.Date{2018, time.May, 05}),
+	}
+
+	capacity := func(s Series) int {
+		switch s := s.(type) {
+		case *SeriesFloat64:
+			return cap(s.Values)
+		case *SeriesInt64:
+			return cap(s.values)
+		case *SeriesString:
+			return cap(s.values)
+		case *SeriesTime:
+			return cap(s.values)
+		case *SeriesGeneric:
+			return cap(s.values)
+		}
+		return 0
+	}
+
+	for i := range init {
+		s := init[i]
+
+		// Remove 3 rows
+		s.Remove(0)
+		s.Remove(0)
+		s.Remove(0)
+
+		s.(interface{ TrimCapacity(...Options) }).TrimCapacity()
+
+		if capacity(s) != 2 {
+			t.Errorf("wrong capacity: expected: %v actual: %v", 2, capacity(s))
+		}
+
+		if s.NRows() != 2 {
+			t.Errorf("wrong val: expected: %v actual: %v", 2, s.NRows())
+		}
+	}
+}
+
+func TestSeriesNUnique(t *testing.T) {
+
+	// Create new series
+	init := []Series{
+		NewSeriesFloat64("test", nil, 1.0, nil, 2.0, 1.0, nil, 3.0),
+		NewSeriesInt64("test", nil, 1, nil, 2, 1, nil, 3),
+		NewSeriesString("test", nil, "1", nil, "2", "1", nil, "3"),
+	}
+
+	for i := range init {
+		s := init[i].(interface {
+			NUnique(...NUniqueOptions) int
+		})
+
+		if s.NUnique() != 3 {
+			t.Errorf("wrong val: expected: %v actual: %v", 3, s.NUnique())
+		}
+
+		if s.NUnique(NUniqueOptions{IncludeNil: true}) != 4 {
+			t.Errorf("wrong val: expected: %v actual: %v", 4, s.NUnique(NUniqueOptions{IncludeNil: true}))
+		}
+	}
+}
+
+func TestSeriesValidate(t *testing.T) {
+
+	// Create new series
+	init := []Series{
+		NewSeriesFloat64("test", &SeriesInit{Size: 1}, 1.0, nil, 2.0),
+		NewSeriesInt64("test", &SeriesInit{Size: 1}, 1, nil, 2),
+		NewSeriesString("test", &SeriesInit{Size: 1}, "1", nil, "2"),
+		NewSeriesTime("test", &SeriesInit{Size: 1}, time.Now(), nil, time.Now()),
+		NewSeriesBool("test", &SeriesInit{Size: 1}, true, nil, false),
+		NewSeriesGeneric("test", civil.Date{}, &SeriesInit{Size: 1}, civil.Date{2018, time.May, 01}, nil, civil.Date{2018, time.May, 02}),
+	}
+
+	for i := range init {
+		s := init[i]
+		s.Append(nil)
+		s.Insert(0, nil)
+		s.Update(1, nil)
+		s.Remove(2)
+
+		v := s.(interface {
+			Validate(...Options) error
+		})
+
+		if err := v.Validate(); err != nil {
+			t.Errorf("wrong val: expected: %v actual: %v", nil, err)
+		}
+	}
+
+	// Detect a desynced nil count
+	s := NewSeriesInt64("test", nil, 1, nil, 2)
+	s.nilCount = 0
+	if err := s.Validate(); err == nil {
+		t.Errorf("wrong val: expected: %v actual: %v", "error", err)
+	}
+
+	// Detect Inf
+	f := NewSeriesFloat64("test", nil, 1.0, math.Inf(1))
+	if err := f.Validate(); err == nil {
+		t.Errorf("wrong val: expected: %v actual: %v", "error", err)
+	}
+}
+
+func TestSeriesForEach(t *testing.T) {
+
+	s := NewSeriesFloat64("test", nil, 1.0, nil, 3.0, 4.0)
+
+	vals := []interface{}{}
+	err := s.ForEach(func(row int, val interface{}) error {
+		vals = append(vals, val)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("wrong val: expected: %v actual: %v", nil, err)
+	}
+
+	expected := []interface{}{1.0, nil, 3.0, 4.0}
+	if !cmp.Equal(vals, expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, vals)
+	}
+
+	// Early termination
+	errStop := fmt.Errorf("stop")
+	var visited int
+	err = s.ForEach(func(row int, val interface{}) error {
+		visited++
+		if row == 1 {
+			return errStop
+		}
+		return nil
+	})
+	if err != errStop {
+		t.Errorf("wrong val: expected: %v actual: %v", errStop, err)
+	}
+	if visited != 2 {
+		t.Errorf("wrong val: expected: %v actual: %v", 2, visited)
+	}
+}
+
+func TestMergeSorted(t *testing.T) {
+
+	a := NewSeriesFloat64("a", nil, nil, 1.0, 3.0, 5.0)
+	b := NewSeriesFloat64("b", nil, nil, 2.0, 3.0, 6.0, 7.0)
+
+	merged, err := MergeSorted(a, b, MergeSortedOptions{Validate: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := NewSeriesFloat64("a", nil, nil, nil, 1.0, 2.0, 3.0, 3.0, 5.0, 6.0, 7.0)
+	if !cmp.Equal(merged, expected, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, merged)
+	}
+
+	if err := merged.Validate(); err != nil {
+		t.Errorf("wrong val: expected: %v actual: %v", nil, err)
+	}
+
+	// Descending
+	a = NewSeriesFloat64("a", nil, 5.0, 1.0, nil)
+	b = NewSeriesFloat64("b", nil, 6.0, 2.0)
+
+	merged, err = MergeSorted(a, b, MergeSortedOptions{SortDesc: true, Validate: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected = NewSeriesFloat64("a", nil, 6.0, 5.0, 2.0, 1.0, nil)
+	if !cmp.Equal(merged, expected, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, merged)
+	}
+
+	// Unsorted
+	_, err = MergeSorted(NewSeriesFloat64("a", nil, 2.0, 1.0), b, MergeSortedOptions{Validate: true})
+	if err == nil {
+		t.Errorf("wrong val: expected: %v actual: %v", "error", err)
+	}
+}
+
+func TestSeriesTreatSmallAsNil(t *testing.T) {
+
+	s := NewSeriesFloat64("test", nil, 1.0, 1e-12, nil, -1e-9, 0.0, -2.0)
+
+	count := s.TreatSmallAsNil(1e-6)
+	if count != 3 {
+		t.Errorf("wrong val: expected: %v actual: %v", 3, count)
+	}
+
+	expected := NewSeriesFloat64("test", nil, 1.0, nil, nil, nil, nil, -2.0)
+	if !cmp.Equal(s, expected, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, s)
+	}
+
+	if err := s.Validate(); err != nil {
+		t.Errorf("wrong val: expected: %v actual: %v", nil, err)
+	}
+}
+
+func TestSeriesSplice(t *testing.T) {
+
+	s1 := NewSeriesFloat64("test", nil, 1.0, nil, 3.0, 4.0, 5.0)
+	err := s1.Splice(RangeFinite(1, 3), []float64{7.0, 8.0})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected1 := NewSeriesFloat64("test", nil, 1.0, 7.0, 8.0, 5.0)
+	if !cmp.Equal(s1, expected1, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected1, s1)
+	}
+	if err := s1.Validate(); err != nil {
+		t.Errorf("wrong val: expected: %v actual: %v", nil, err)
+	}
+
+	s2 := NewSeriesInt64("test", nil, 1, 2, 3)
+	err = s2.Splice(RangeFinite(0, 0), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = s2.Splice(RangeFinite(2, 2), []int64{4, 5, 6})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected2 := NewSeriesInt64("test", nil, nil, 2, 4, 5, 6)
+	if fmt.Sprint(s2) != fmt.Sprint(expected2) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected2, s2)
+	}
+	if err := s2.Validate(); err != nil {
+		t.Errorf("wrong val: expected: %v actual: %v", nil, err)
+	}
+
+	// Invalid range
+	if err := s2.Splice(RangeFinite(10, 12), int64(1)); err == nil {
+		t.Errorf("wrong val: expected: %v actual: %v", "error", err)
+	}
+}
+
+func TestSeriesSortByKey(t *testing.T) {
+
+	s1 := NewSeriesString("test", nil, "c", "a", nil, "b")
+	err := s1.SortByKey([]float64{3.0, 1.0, math.NaN(), 2.0})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected1 := NewSeriesString("test", nil, nil, "a", "b", "c")
+	if fmt.Sprint(s1) != fmt.Sprint(expected1) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected1, s1)
+	}
+
+	s2 := NewSeriesFloat64("test", nil, 1.0, 2.0, 3.0)
+	err = s2.SortByKeyInt64([]int64{20, 30, 10}, Options{SortDesc: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected2 := NewSeriesFloat64("test", nil, 2.0, 1.0, 3.0)
+	if !cmp.Equal(s2, expected2, cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected2, s2)
+	}
+
+	s3 := NewSeriesInt64("test", nil, 1, 2, 3)
+	err = s3.SortByKeyGeneric([]interface{}{"b", "c", "a"}, func(a, b interface{}) bool {
+		return a.(string) < b.(string)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected3 := NewSeriesInt64("test", nil, 3, 1, 2)
+	if fmt.Sprint(s3) != fmt.Sprint(expected3) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected3, s3)
+	}
+
+	if err := s3.SortByKey([]float64{1.0}); err != ErrMismatchedRows {
+		t.Errorf("wrong val: expected: %v actual: %v", ErrMismatchedRows, err)
+	}
+}
+
+func TestSeriesCombineFirst(t *testing.T) {
+
+	s := NewSeriesFloat64("test", nil, 1.0, nil, 3.0, nil)
+	other := NewSeriesFloat64("other", nil, 10.0, 20.0, nil, nil)
+
+	combined, err := s.CombineFirst(other)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := NewSeriesFloat64("test", nil, 1.0, 20.0, 3.0, nil)
+	if !cmp.Equal(combined, expected, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, combined)
+	}
+	if err := combined.Validate(); err != nil {
+		t.Errorf("wrong val: expected: %v actual: %v", nil, err)
+	}
+
+	mask := NewSeriesBool("mask", nil, true, true, nil, false)
+	err = s.SetWhere(mask, other)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected = NewSeriesFloat64("test", nil, 10.0, 20.0, 3.0, nil)
+	if !cmp.Equal(s, expected, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, s)
+	}
+	if err := s.Validate(); err != nil {
+		t.Errorf("wrong val: expected: %v actual: %v", nil, err)
+	}
+
+	if _, err := s.CombineFirst(NewSeriesFloat64("other", nil, 1.0)); err != ErrMismatchedRows {
+		t.Errorf("wrong val: expected: %v actual: %v", ErrMismatchedRows, err)
+	}
+}
+
+func TestSeriesRolling(t *testing.T) {
+
+	s := NewSeriesFloat64("test", nil, 1.0, 2.0, 3.0, 4.0, nil, 6.0)
+
+	tests := []struct {
+		fn       func(int, ...RollingOptions) (*SeriesFloat64, error)
+		window   int
+		opts     RollingOptions
+		expected *SeriesFloat64
+	}{
+		{s.RollingMean, 3, RollingOptions{}, NewSeriesFloat64("test", nil, nil, nil, 2.0, 3.0, nil, nil)},
+		{s.RollingSum, 2, RollingOptions{}, NewSeriesFloat64("test", nil, nil, 3.0, 5.0, 7.0, nil, nil)},
+		{s.RollingMean, 3, RollingOptions{Center: true}, NewSeriesFloat64("test", nil, nil, 2.0, 3.0, nil, nil, nil)},
+		{s.RollingSum, 4, RollingOptions{Center: true}, NewSeriesFloat64("test", nil, nil, nil, 10.0, nil, nil, nil)},
+	}
+
+	for i, tc := range tests {
+		out, err := tc.fn(tc.window, tc.opts)
+		if err != nil {
+			t.Fatalf("%d: unexpected error: %v", i, err)
+		}
+
+		if !cmp.Equal(out, tc.expected, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+			t.Errorf("%d: wrong val: expected: %v actual: %v", i, tc.expected, out)
+		}
+	}
+}
+
+func TestPipe(t *testing.T) {
+
+	s := NewSeriesFloat64("test", nil, 1.0, 2.0, 3.0)
+
+	double := func(s Series) (Series, error) {
+		ns := s.Copy().(*SeriesFloat64)
+		for i := range ns.Values {
+			ns.Values[i] = ns.Values[i] * 2
+		}
+		return ns, nil
+	}
+
+	out, err := Pipe(s, double, double)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := NewSeriesFloat64("test", nil, 4.0, 8.0, 12.0)
+	if !cmp.Equal(out, expected, cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, out)
+	}
+
+	// Short-circuit on error
+	errStop := fmt.Errorf("stop")
+	var called bool
+	_, err = Pipe(s, func(s Series) (Series, error) {
+		return nil, errStop
+	}, func(s Series) (Series, error) {
+		called = true
+		return s, nil
+	})
+	if err != errStop || called {
+		t.Errorf("wrong val: expected: %v actual: %v", errStop, err)
+	}
+}
+
+func TestSeriesTypedAt(t *testing.T) {
+
+	tm := time.Date(2019, time.May, 1, 0, 0, 0, 0, time.UTC)
+
+	s1 := NewSeriesString("test", nil, "a", nil)
+	if v, ok := s1.StringAt(0); v != "a" || !ok {
+		t.Errorf("wrong val: expected: %v actual: %v", "a", v)
+	}
+	if v, ok := s1.StringAt(1); v != "" || ok {
+		t.Errorf("wrong val: expected: %v actual: %v", nil, v)
+	}
+
+	s2 := NewSeriesBool("test", nil, true, nil)
+	if v, ok := s2.BoolAt(0); v != true || !ok {
+		t.Errorf("wrong val: expected: %v actual: %v", true, v)
+	}
+	if v, ok := s2.BoolAt(1); v != false || ok {
+		t.Errorf("wrong val: expected: %v actual: %v", nil, v)
+	}
+
+	s3 := NewSeriesTime("test", nil, tm, nil)
+	if v, ok := s3.TimeAt(0); !v.Equal(tm) || !ok {
+		t.Errorf("wrong val: expected: %v actual: %v", tm, v)
+	}
+	if v, ok := s3.TimeAt(1); !v.IsZero() || ok {
+		t.Errorf("wrong val: expected: %v actual: %v", nil, v)
+	}
+}
+
+func TestNilValueFormatter(t *testing.T) {
+
+	s := NewSeriesGeneric("test", float64(0), nil, math.NaN(), nil, 1.5)
+
+	// By default, missing and NaN values are indistinguishable
+	if s.ValueString(0) != s.ValueString(1) {
+		t.Errorf("wrong val: expected: %v actual: %v", s.ValueString(0), s.ValueString(1))
+	}
+
+	s.SetValueToStringFormatter(NilValueFormatter(""))
+
+	expected := []string{"NaN", "", "1.5"}
+	for i := range expected {
+		if s.ValueString(i) != expected[i] {
+			t.Errorf("wrong val: expected: %v actual: %v", expected[i], s.ValueString(i))
+		}
+	}
+}
+
+func TestSeriesClip(t *testing.T) {
+
+	s := NewSeriesFloat64("test", nil, -5.0, 1.0, nil, 3.0, 10.0)
+
+	count, err := s.Clip(0, 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("wrong val: expected: %v actual: %v", 2, count)
+	}
+
+	expected := NewSeriesFloat64("test", nil, 0.0, 1.0, nil, 3.0, 5.0)
+	if !cmp.Equal(s, expected, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, s)
+	}
+
+	// No lower bound
+	count, _ = s.Clip(math.NaN(), 2)
+	if count != 2 {
+		t.Errorf("wrong val: expected: %v actual: %v", 2, count)
+	}
+
+	if _, err := s.Clip(5, 0); err == nil {
+		t.Errorf("wrong val: expected: %v actual: %v", "error", err)
+	}
+
+	s = NewSeriesFloat64("test", nil, 1.0, 2.0, 3.0, 4.0, 5.0, nil, 100.0)
+	count, err = s.Winsorize(0, 0.8)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("wrong val: expected: %v actual: %v", 1, count)
+	}
+
+	expected = NewSeriesFloat64("test", nil, 1.0, 2.0, 3.0, 4.0, 5.0, nil, 5.0)
+	if !cmp.Equal(s, expected, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, s)
+	}
+}
+
+func TestSeriesInt64Clip(t *testing.T) {
+
+	s := NewSeriesInt64("test", nil, -3, 1, nil, 10)
+
+	clipped, err := s.Clip(&[]int64{0}[0], &[]int64{5}[0])
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	expected := NewSeriesInt64("test", nil, 0, 1, nil, 5)
+	if fmt.Sprint(clipped) != fmt.Sprint(expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, clipped)
+	}
+
+	// Original series must be unchanged
+	expected = NewSeriesInt64("test", nil, -3, 1, nil, 10)
+	if fmt.Sprint(s) != fmt.Sprint(expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, s)
+	}
+
+	clipped, _ = s.Clip(nil, &[]int64{2}[0])
+	expected = NewSeriesInt64("test", nil, -3, 1, nil, 2)
+	if fmt.Sprint(clipped) != fmt.Sprint(expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, clipped)
+	}
+
+	if _, err := s.Clip(&[]int64{5}[0], &[]int64{0}[0]); err == nil {
+		t.Errorf("expected error when min > max")
+	}
+}
+
+func TestSeriesArithmetic(t *testing.T) {
+
+	a := NewSeriesFloat64("a", nil, 1.0, 2.0, nil, 4.0, 0.0, -1.0)
+	b := NewSeriesFloat64("b", nil, 2.0, 4.0, 1.0, 0.0, 0.0, 0.0)
+
+	tests := []struct {
+		fn       func(*SeriesFloat64) (*SeriesFloat64, error)
+		expected *SeriesFloat64
+	}{
+		{a.Add, NewSeriesFloat64("a", nil, 3.0, 6.0, nil, 4.0, 0.0, -1.0)},
+		{a.Subtract, NewSeriesFloat64("a", nil, -1.0, -2.0, nil, 4.0, 0.0, -1.0)},
+		{a.Multiply, NewSeriesFloat64("a", nil, 2.0, 8.0, nil, 0.0, 0.0, -0.0)},
+		{a.Divide, NewSeriesFloat64("a", nil, 0.5, 0.5, nil, math.Inf(1), nil, math.Inf(-1))},
+	}
+
+	for i, tc := range tests {
+		out, err := tc.fn(b)
+		if err != nil {
+			t.Fatalf("%d: unexpected error: %v", i, err)
+		}
+
+		if !cmp.Equal(out, tc.expected, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+			t.Errorf("%d: wrong val: expected: %v actual: %v", i, tc.expected, out)
+		}
+	}
+
+	if _, err := a.Add(NewSeriesFloat64("c", nil, 1.0)); err != ErrMismatchedRows {
+		t.Errorf("wrong val: expected: %v actual: %v", ErrMismatchedRows, err)
+	}
+}
+
+func TestSeriesStats(t *testing.T) {
+
+	s := NewSeriesFloat64("test", nil, 2.0, 4.0, nil, 4.0, 4.0, 5.0, 5.0, 7.0, 9.0)
+
+	if s.Sum() != 40.0 {
+		t.Errorf("wrong val: expected: %v actual: %v", 40.0, s.Sum())
+	}
+
+	if s.Mean() != 5.0 {
+		t.Errorf("wrong val: expected: %v actual: %v", 5.0, s.Mean())
+	}
+
+	if math.Abs(s.Variance()-32.0/7) > 1e-12 {
+		t.Errorf("wrong val: expected: %v actual: %v", 32.0/7, s.Variance())
+	}
+
+	if math.Abs(s.StdDev()-math.Sqrt(32.0/7)) > 1e-12 {
+		t.Errorf("wrong val: expected: %v actual: %v", math.Sqrt(32.0/7), s.StdDev())
+	}
+
+	empty := NewSeriesFloat64("test", nil, nil)
+	if empty.Sum() != 0 || !math.IsNaN(empty.Mean()) || !math.IsNaN(empty.StdDev()) {
+		t.Errorf("wrong val: expected: %v actual: %v %v %v", "0 NaN NaN", empty.Sum(), empty.Mean(), empty.StdDev())
+	}
+}
+
+func TestSeriesEWMStd(t *testing.T) {
+
+	s := NewSeriesFloat64("test", nil, nil, 1.0, 3.0, nil, 5.0)
+
+	// seed variance = 1
+	lambda := 0.5
+	v1 := 1.0
+	v2 := lambda*v1 + (1-lambda)*9
+	v3 := lambda*v2 + (1-lambda)*25
+
+	expected := NewSeriesFloat64("test", nil, nil, math.Sqrt(v1), math.Sqrt(v2), math.Sqrt(v2), math.Sqrt(v3))
+
+	actual, err := s.EWMStd(lambda)
+	if err != nil {
+		t.Errorf("error encountered: %s\n", err)
+	}
+
+	if !cmp.Equal(expected, actual, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual)
+	}
+
+	// Appending values must not change earlier values (no look-ahead)
+	s.Append(100.0)
+	s.Append(-7.0)
+
+	extended, err := s.EWMStd(lambda)
+	if err != nil {
+		t.Errorf("error encountered: %s\n", err)
+	}
+
+	if !cmp.Equal(expected.Values, extended.Values[:expected.NRows()], cmpopts.EquateNaNs()) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, extended)
+	}
+
+	if _, err := NewSeriesFloat64("test", nil, nil).EWMStd(lambda); err != ErrNoValues {
+		t.Errorf("wrong val: expected: %v actual: %v", ErrNoValues, err)
+	}
+
+	if _, err := s.EWMStd(1); err == nil {
+		t.Errorf("wrong val: expected: %v actual: %v", "error", err)
+	}
+}
+
+func TestSeriesMinMax(t *testing.T) {
+
+	sf := NewSeriesFloat64("test", nil, nil, 3.0, -1.0, 7.0, -1.0, 7.0)
+	si := NewSeriesInt64("test", nil, nil, 3, -1, 7, -1, 7)
+
+	minF, _ := sf.Min()
+	maxF, _ := sf.Max()
+	minI, _ := si.Min()
+	maxI, _ := si.Max()
+
+	if minF != -1.0 || maxF != 7.0 || minI != -1 || maxI != 7 {
+		t.Errorf("wrong val: expected: %v actual: %v", "-1 7 -1 7", fmt.Sprint(minF, maxF, minI, maxI))
+	}
+
+	argMinF, _ := sf.ArgMin()
+	argMaxF, _ := sf.ArgMax()
+	argMinI, _ := si.ArgMin()
+	argMaxI, _ := si.ArgMax()
+
+	if argMinF != 2 || argMaxF != 3 || argMinI != 2 || argMaxI != 3 {
+		t.Errorf("wrong val: expected: %v actual: %v", "2 3 2 3", fmt.Sprint(argMinF, argMaxF, argMinI, argMaxI))
+	}
+
+	if _, err := NewSeriesFloat64("test", nil, nil).Min(); err != ErrNoValues {
+		t.Errorf("wrong val: expected: %v actual: %v", ErrNoValues, err)
+	}
+
+	if _, err := NewSeriesInt64("test", nil, nil).ArgMax(); err != ErrNoValues {
+		t.Errorf("wrong val: expected: %v actual: %v", ErrNoValues, err)
+	}
+}
+
+func TestSeriesFillNaN(t *testing.T) {
+
+	tests := []struct {
+		strategy FillStrategy
+		expected *SeriesFloat64
+	}{
+		{
+			FillConstant(0),
+			NewSeriesFloat64("test", nil, 0.0, 1.0, 0.0, 0.0, 4.0, 0.0),
+		},
+		{
+			ForwardFill,
+			NewSeriesFloat64("test", nil, nil, 1.0, 1.0, 1.0, 4.0, 4.0),
+		},
+		{
+			BackwardFill,
+			NewSeriesFloat64("test", nil, 1.0, 1.0, 4.0, 4.0, 4.0, nil),
+		},
+		{
+			LinearInterpolation,
+			NewSeriesFloat64("test", nil, nil, 1.0, 2.0, 3.0, 4.0, nil),
+		},
+	}
+
+	for i, tc := range tests {
+		s := NewSeriesFloat64("test", nil, nil, 1.0, nil, nil, 4.0, nil)
+		s.FillNaN(tc.strategy)
+
+		if !cmp.Equal(tc.expected, s, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+			t.Errorf("wrong val %d: expected: %v actual: %v", i, tc.expected, s)
+		}
+
+		if s.ContainsNil() != tc.expected.ContainsNil() {
+			t.Errorf("wrong val %d: expected: %v actual: %v", i, tc.expected.ContainsNil(), s.ContainsNil())
+		}
+
+		if err := s.Validate(); err != nil {
+			t.Errorf("error encountered %d: %s\n", i, err)
+		}
+	}
+}
+
+func TestSeriesFillNaNInf(t *testing.T) {
+
+	s := NewSeriesFloat64("test", nil, math.Inf(-1), nil, math.Inf(1), nil, 4.0)
+	s.FillNaN(LinearInterpolation)
+
+	// Interpolating between infinite values produces NaN, so the nil values remain
+	expected := NewSeriesFloat64("test", nil, math.Inf(-1), nil, math.Inf(1), nil, 4.0)
+	if !cmp.Equal(expected, s, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, s)
+	}
+
+	// Validate rejects Inf values so check the cached nil count directly
+	if s.nilCount != expected.nilCount {
+		t.Errorf("wrong val: expected: %v actual: %v", expected.nilCount, s.nilCount)
+	}
+
+	// Once the Inf values are removed, the series is valid
+	s.Update(0, nil)
+	s.Update(2, nil)
+	if err := s.Validate(); err != nil {
+		t.Errorf("error encountered: %s\n", err)
+	}
+}
+
+func TestSeriesCopyNilCount(t *testing.T) {
+
+	tRef := time.Date(2017, 1, 1, 5, 30, 12, 0, time.UTC)
+
+	init := &SeriesInit{Size: 100}
+	seriess := []Series{
+		NewSeriesFloat64("test", init),
+		NewSeriesInt64("test", init),
+		NewSeriesString("test", init),
+		NewSeriesTime("test", init),
+		NewSeriesBool("test", init),
+		NewSeriesGeneric("test", civil.Date{}, init),
+	}
+	heads := []interface{}{1.0, int64(1), "1", tRef, true, civil.Date{2018, time.May, 1}}
+
+	for i, s := range seriess {
+		for row := 0; row < 3; row++ {
+			s.Update(row, heads[i])
+		}
+
+		cp := s.Copy(RangeFinite(0, 2))
+		if cp.(interface{ ContainsNil() bool }).ContainsNil() {
+			t.Errorf("wrong val %d: expected: %v actual: %v", i, false, true)
+		}
+
+		if err := cp.(interface{ Validate(...Options) error }).Validate(); err != nil {
+			t.Errorf("error encountered %d: %s\n", i, err)
+		}
+	}
+}
+
+func TestSeriesPrependNilCount(t *testing.T) {
+
+	init := &SeriesInit{Capacity: 10}
+	seriess := []Series{
+		NewSeriesFloat64("test", init, 1.0),
+		NewSeriesInt64("test", init, 1),
+		NewSeriesString("test", init, "1"),
+		NewSeriesTime("test", init, time.Date(2017, 1, 1, 5, 30, 12, 0, time.UTC)),
+		NewSeriesBool("test", init, true),
+		NewSeriesGeneric("test", civil.Date{}, init, civil.Date{2018, time.May, 1}),
+	}
+
+	for i, s := range seriess {
+		s.Prepend(nil)
+		if !s.(interface{ ContainsNil() bool }).ContainsNil() {
+			t.Errorf("wrong val %d: expected: %v actual: %v", i, true, false)
+		}
+
+		s.Prepend(s.Value(1))
+		if err := s.(interface{ Validate(...Options) error }).Validate(); err != nil {
+			t.Errorf("error encountered %d: %s\n", i, err)
+		}
+	}
+
+	// Prepending NaN is equivalent to prepending nil
+	sf := NewSeriesFloat64("test", init, 1.0)
+	sf.Prepend(math.NaN())
+	if !sf.ContainsNil() {
+		t.Errorf("wrong val: expected: %v actual: %v", true, false)
+	}
+}
+
+func TestSeriesInt64InsertNil(t *testing.T) {
+
+	s := NewSeriesInt64("test", nil, 1, 2, 3)
+	s.Insert(1, nil)
+
+	expected := NewSeriesInt64("test", nil, 1, nil, 2, 3)
+
+	if fmt.Sprint(s) != fmt.Sprint(expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, s)
+	}
+
+	if s.Value(1) != nil || !s.ContainsNil() {
+		t.Errorf("wrong val: expected: %v actual: %v", nil, s.Value(1))
+	}
+
+	if err := s.Validate(); err != nil {
+		t.Errorf("error encountered: %s\n", err)
+	}
+}
+
+func TestSeriesBoolFromString(t *testing.T) {
+
+	s := NewSeriesBool("test", nil, "true", "1", "false", "0", nil, true)
+	expected := NewSeriesBool("test", nil, true, true, false, false, nil, true)
+
+	if expected.Table() != s.Table() {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, s)
+	}
+}
+
+func TestSeriesTimeRFC3339(t *testing.T) {
+
+	tm := time.Date(2017, 1, 1, 5, 30, 12, 0, time.UTC)
+
+	s := NewSeriesTime("test", nil, "2017-01-01T05:30:12Z", nil, tm.Add(-time.Hour))
+
+	if !s.Value(0).(time.Time).Equal(tm) {
+		t.Errorf("wrong val: expected: %v actual: %v", tm, s.Value(0))
+	}
+
+	if s.ValueString(0) != "2017-01-01T05:30:12Z" {
+		t.Errorf("wrong val: expected: %v actual: %v", "2017-01-01T05:30:12Z", s.ValueString(0))
+	}
+
+	s.Sort()
+	if s.ValueString(0) != "NaN" || s.ValueString(1) != "2017-01-01T04:30:12Z" {
+		t.Errorf("wrong val: expected: %v actual: %v", "[NaN 2017-01-01T04:30:12Z ...]", s)
+	}
+
+	s.SetValueToStringFormatter(func(v interface{}) string {
+		if v == nil {
+			return ""
+		}
+		return v.(time.Time).Format("2006-01-02")
+	})
+	if s.ValueString(2) != "2017-01-01" {
+		t.Errorf("wrong val: expected: %v actual: %v", "2017-01-01", s.ValueString(2))
+	}
+}
+
+func TestSeriesApply(t *testing.T) {
+
+	s := NewSeriesFloat64("test", nil, 1.0, nil, 3.0)
+
+	doubled := s.Apply(func(val float64, row int) float64 {
+		return val * 2
+	})
+
+	expected := NewSeriesFloat64("test", nil, 2.0, nil, 6.0)
+	if !cmp.Equal(expected, doubled, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, doubled)
+	}
+
+	if err := doubled.Validate(); err != nil {
+		t.Errorf("error encountered: %s\n", err)
+	}
+
+	filled := s.Apply(func(val float64, row int) float64 {
+		if math.IsNaN(val) {
+			return float64(row)
+		}
+		return val
+	}, ApplyOptions{PassNil: true})
+
+	expected = NewSeriesFloat64("test", nil, 1.0, 1.0, 3.0)
+	if !cmp.Equal(expected, filled, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, filled)
+	}
+}
+
+func TestSeriesApplyInPlace(t *testing.T) {
+
+	sf := NewSeriesFloat64("test", nil, 1.0, nil, 3.0)
+	sf.ApplyInPlace(func(val float64, row int) float64 {
+		if math.IsNaN(val) {
+			return 0
+		}
+		if val > 2 {
+			return math.NaN()
+		}
+		return val * 2
+	}, ApplyOptions{PassNil: true})
+
+	expectedF := NewSeriesFloat64("test", nil, 2.0, 0.0, nil)
+	if !cmp.Equal(expectedF, sf, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expectedF, sf)
+	}
+
+	if err := sf.Validate(); err != nil {
+		t.Errorf("error encountered: %s\n", err)
+	}
+
+	si := NewSeriesInt64("test", nil, 1, nil, 3)
+	si.ApplyInPlace(func(val int64, row int) int64 {
+		return val * 2
+	})
+
+	expectedI := NewSeriesInt64("test", nil, 2, nil, 6)
+	if expectedI.Table() != si.Table() {
+		t.Errorf("wrong val: expected: %v actual: %v", expectedI, si)
+	}
+}
+
+func TestSeriesFilter(t *testing.T) {
+
+	sf := NewSeriesFloat64("test", nil, 1.0, nil, 3.0, 60.0)
+	si := NewSeriesInt64("test", nil, 1, nil, 3, 60)
+
+	rows := sf.Filter(func(val interface{}, row int) bool {
+		return val == nil || val.(float64) > 2
+	})
+	if !cmp.Equal([]int{1, 2, 3}, rows) {
+		t.Errorf("wrong val: expected: %v actual: %v", []int{1, 2, 3}, rows)
+	}
+
+	rows = si.Filter(func(val interface{}, row int) bool {
+		return val != nil && val.(int64) > 2
+	})
+	if !cmp.Equal([]int{2, 3}, rows) {
+		t.Errorf("wrong val: expected: %v actual: %v", []int{2, 3}, rows)
+	}
+}
+
+func TestSeriesCumulative(t *testing.T) {
+
+	s := NewSeriesFloat64("test", nil, nil, 1.0, 2.0, nil, 3.0)
+
+	tests := []struct {
+		actual   *SeriesFloat64
+		expected *SeriesFloat64
+	}{
+		{s.CumSum(), NewSeriesFloat64("test", nil, nil, 1.0, 3.0, 3.0, 6.0)},
+		{s.CumSum(CumulativeOptions{KeepNil: true}), NewSeriesFloat64("test", nil, nil, 1.0, 3.0, nil, 6.0)},
+		{s.CumProd(), NewSeriesFloat64("test", nil, nil, 1.0, 2.0, 2.0, 6.0)},
+		{s.CumProd(CumulativeOptions{KeepNil: true}), NewSeriesFloat64("test", nil, nil, 1.0, 2.0, nil, 6.0)},
+	}
+
+	for i, tc := range tests {
+		if !cmp.Equal(tc.expected, tc.actual, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+			t.Errorf("wrong val %d: expected: %v actual: %v", i, tc.expected, tc.actual)
+		}
+	}
+}
+
+func TestSeriesDiff(t *testing.T) {
+
+	s := NewSeriesFloat64("test", nil, 1.0, 2.0, 4.0, nil, 8.0)
+
+	tests := []struct {
+		actual   *SeriesFloat64
+		expected *SeriesFloat64
+	}{
+		{s.Diff(1), NewSeriesFloat64("test", nil, nil, 1.0, 2.0, nil, nil)},
+		{s.Diff(2), NewSeriesFloat64("test", nil, nil, nil, 3.0, nil, 4.0)},
+		{s.Diff(-1), NewSeriesFloat64("test", nil, -1.0, -2.0, nil, nil, nil)},
+		{s.PctChange(1), NewSeriesFloat64("test", nil, nil, 1.0, 1.0, nil, nil)},
+		{s.PctChange(2), NewSeriesFloat64("test", nil, nil, nil, 3.0, nil, 1.0)},
+	}
+
+	for i, tc := range tests {
+		if !cmp.Equal(tc.expected, tc.actual, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+			t.Errorf("wrong val %d: expected: %v actual: %v", i, tc.expected, tc.actual)
+		}
+	}
+}
+
+func TestSeriesShift(t *testing.T) {
+
+	sf := NewSeriesFloat64("test", nil, 1.0, 2.0, nil, 4.0)
+	si := NewSeriesInt64("test", nil, 1, 2, nil, 4)
+
+	tests := []struct {
+		actual   Series
+		expected Series
+	}{
+		{sf.Shift(1), NewSeriesFloat64("test", nil, nil, 1.0, 2.0, nil)},
+		{sf.Shift(-2), NewSeriesFloat64("test", nil, nil, 4.0, nil, nil)},
+		{sf.Shift(5), NewSeriesFloat64("test", nil, nil, nil, nil, nil)},
+		{si.Shift(1), NewSeriesInt64("test", nil, nil, 1, 2, nil)},
+		{si.Shift(-2), NewSeriesInt64("test", nil, nil, 4, nil, nil)},
+	}
+
+	for i, tc := range tests {
+		if fmt.Sprint(tc.expected) != fmt.Sprint(tc.actual) {
+			t.Errorf("wrong val %d: expected: %v actual: %v", i, tc.expected, tc.actual)
+		}
+
+		if err := tc.actual.(interface{ Validate(...Options) error }).Validate(); err != nil {
+			t.Errorf("error encountered %d: %s\n", i, err)
+		}
+	}
+}
+
+func TestSeriesRollingAggregations(t *testing.T) {
+
+	s := NewSeriesFloat64("test", nil, 1.0, 3.0, nil, 2.0, 6.0)
+
+	tests := []struct {
+		fn       func() (*SeriesFloat64, error)
+		expected *SeriesFloat64
+	}{
+		{s.Rolling(2).Mean, NewSeriesFloat64("test", nil, nil, 2.0, nil, nil, 4.0)},
+		{s.Rolling(2).Min, NewSeriesFloat64("test", nil, nil, 1.0, nil, nil, 2.0)},
+		{s.Rolling(2).Max, NewSeriesFloat64("test", nil, nil, 3.0, nil, nil, 6.0)},
+		{s.Rolling(2, RollingOptions{MinPeriods: 1}).Sum, NewSeriesFloat64("test", nil, 1.0, 4.0, 3.0, 2.0, 8.0)},
+		{s.Rolling(3, RollingOptions{MinPeriods: 2}).Max, NewSeriesFloat64("test", nil, nil, 3.0, 3.0, 3.0, 6.0)},
+		{s.Rolling(3, RollingOptions{MinPeriods: 2}).StdDev, NewSeriesFloat64("test", nil, nil, math.Sqrt(2), math.Sqrt(2), math.Sqrt(0.5), math.Sqrt(8))},
+	}
+
+	for i, tc := range tests {
+		actual, err := tc.fn()
+		if err != nil {
+			t.Errorf("error encountered %d: %s\n", i, err)
+			continue
+		}
+
+		if !cmp.Equal(tc.expected, actual, cmpopts.EquateNaNs(), cmpopts.EquateApprox(0, 1e-12), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+			t.Errorf("wrong val %d: expected: %v actual: %v", i, tc.expected, actual)
+		}
+	}
+
+	if _, err := s.Rolling(2, RollingOptions{MinPeriods: 3}).Mean(); err == nil {
+		t.Errorf("wrong val: expected: %v actual: %v", "error", err)
+	}
+}
+
+func TestSeriesQuantile(t *testing.T) {
+
+	s := NewSeriesFloat64("latency", nil, 15, nil, 20, 35, 40, 50)
+
+	tests := []struct {
+		q        float64
+		expected float64
+	}{
+		{0, 15},
+		{0.25, 20},
+		{0.4, 29},
+		{0.5, 35},
+		{1, 50},
+	}
+
+	for i, tc := range tests {
+		actual, err := s.Quantile(tc.q)
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+		if !cmp.Equal(actual, tc.expected, cmpopts.EquateApprox(0, 1e-9)) {
+			t.Errorf("%d: wrong val: expected: %v actual: %v", i, tc.expected, actual)
+		}
+	}
+
+	p, err := s.Percentile(75)
+	if err != nil || p != 40 {
+		t.Errorf("wrong val: expected: %v actual: %v (%v)", 40, p, err)
+	}
+
+	// Original order must be preserved
+	expected := NewSeriesFloat64("latency", nil, 15, nil, 20, 35, 40, 50)
+	if !cmp.Equal(s, expected, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, s)
+	}
+
+	if _, err := s.Quantile(1.5); err == nil {
+		t.Errorf("expected error for q out of range")
+	}
+
+	if _, err := NewSeriesFloat64("empty", nil, nil).Quantile(0.5); err != ErrNoValues {
+		t.Errorf("wrong val: expected: %v actual: %v", ErrNoValues, err)
+	}
+}
+
+func TestSeriesMedianMode(t *testing.T) {
+
+	sf := NewSeriesFloat64("f", nil, 3, nil, 1, 4, 1, 4)
+	si := NewSeriesInt64("i", nil, 3, nil, 1, 4, 2)
+
+	if m, err := sf.Median(); err != nil || m != 3 {
+		t.Errorf("wrong val: expected: %v actual: %v (%v)", 3, m, err)
+	}
+
+	if m, err := si.Median(); err != nil || m != 2.5 {
+		t.Errorf("wrong val: expected: %v actual: %v (%v)", 2.5, m, err)
+	}
+
+	if m, err := sf.Mode(); err != nil || !cmp.Equal(m, []float64{1, 4}) {
+		t.Errorf("wrong val: expected: %v actual: %v (%v)", []float64{1, 4}, m, err)
+	}
+
+	if m, err := si.Mode(); err != nil || !cmp.Equal(m, []int64{1, 2, 3, 4}) {
+		t.Errorf("wrong val: expected: %v actual: %v (%v)", []int64{1, 2, 3, 4}, m, err)
+	}
+
+	if _, err := NewSeriesInt64("empty", nil, nil).Median(); err != ErrNoValues {
+		t.Errorf("wrong val: expected: %v actual: %v", ErrNoValues, err)
+	}
+
+	if _, err := NewSeriesFloat64("empty", nil, nil).Mode(); err != ErrNoValues {
+		t.Errorf("wrong val: expected: %v actual: %v", ErrNoValues, err)
+	}
+}
+
+func TestSeriesUniqueValueCounts(t *testing.T) {
+
+	sf := NewSeriesFloat64("f", nil, 3, nil, 1, 3, nil, 2)
+	ss := NewSeriesString("s", nil, "b", "a", nil, "b")
+
+	if actual, expected := sf.Unique(), []interface{}{3.0, 1.0, 2.0}; !cmp.Equal(actual, expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual)
+	}
+
+	if actual, expected := sf.Unique(NUniqueOptions{IncludeNil: true}), []interface{}{3.0, nil, 1.0, 2.0}; !cmp.Equal(actual, expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual)
+	}
+
+	if actual, expected := ss.Unique(), []interface{}{"b", "a"}; !cmp.Equal(actual, expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual)
+	}
+
+	if actual, expected := sf.ValueCounts(), map[interface{}]int{3.0: 2, 1.0: 1, 2.0: 1}; !cmp.Equal(actual, expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual)
+	}
+
+	if actual, expected := ss.ValueCounts(NUniqueOptions{IncludeNil: true}), map[interface{}]int{"b": 2, "a": 1, nil: 1}; !cmp.Equal(actual, expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual)
+	}
+}
+
+func TestSeriesIsNull(t *testing.T) {
+
+	sf := NewSeriesFloat64("f", nil, 1, nil, 3, 4)
+	ss := NewSeriesString("s", nil, "a", "b", nil, "d")
+
+	expected := NewSeriesBool("f", nil, false, true, false, false)
+	if actual := sf.IsNull(); fmt.Sprint(actual) != fmt.Sprint(expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual)
+	}
+
+	mask, err := sf.IsNull().Or(ss.IsNull())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected = NewSeriesBool("f", nil, false, true, true, false)
+	if fmt.Sprint(mask) != fmt.Sprint(expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, mask)
+	}
+}
+
+func TestSeriesDropNil(t *testing.T) {
+
+	s := NewSeriesFloat64("f", nil, nil, 1, nil, nil, 2)
+
+	if removed := s.DropNil(); removed != 3 {
+		t.Errorf("wrong val: expected: %v actual: %v", 3, removed)
+	}
+
+	expected := NewSeriesFloat64("f", nil, 1, 2)
+	if !cmp.Equal(s, expected, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, s)
+	}
+
+	if err := s.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestSeriesNormalizeStandardize(t *testing.T) {
+
+	s := NewSeriesFloat64("f", nil, 2, nil, 4, 6)
+
+	expected := NewSeriesFloat64("f", nil, 0, nil, 0.5, 1)
+	if actual := s.Normalize(); !cmp.Equal(actual, expected, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual)
+	}
+
+	expected = NewSeriesFloat64("f", nil, -1, nil, 0, 1)
+	if actual := s.Standardize(); !cmp.Equal(actual, expected, cmpopts.EquateNaNs(), cmpopts.EquateApprox(0, 1e-9), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual)
+	}
+
+	// Degenerate series
+	s = NewSeriesFloat64("f", nil, 3, 3, nil)
+
+	expected = NewSeriesFloat64("f", nil, 0, 0, nil)
+	if actual := s.Normalize(); !cmp.Equal(actual, expected, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual)
+	}
+	if actual := s.Standardize(); !cmp.Equal(actual, expected, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual)
+	}
+}
+
+func TestSeriesRounding(t *testing.T) {
+
+	s := NewSeriesFloat64("f", nil, -1.5, nil, 2.345, 2.5, -0.25)
+
+	tests := []struct {
+		actual   *SeriesFloat64
+		expected *SeriesFloat64
+	}{
+		{s.Abs(), NewSeriesFloat64("f", nil, 1.5, nil, 2.345, 2.5, 0.25)},
+		{s.Round(0), NewSeriesFloat64("f", nil, -2.0, nil, 2.0, 3.0, -0.0)},
+		{s.Round(1), NewSeriesFloat64("f", nil, -1.5, nil, 2.3, 2.5, -0.3)},
+		{s.Floor(), NewSeriesFloat64("f", nil, -2.0, nil, 2.0, 2.0, -1.0)},
+		{s.Ceil(), NewSeriesFloat64("f", nil, -1.0, nil, 3.0, 3.0, -0.0)},
+	}
+
+	for i, tc := range tests {
+		if !cmp.Equal(tc.actual, tc.expected, cmpopts.EquateNaNs(), cmpopts.EquateApprox(0, 1e-9), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+			t.Errorf("%d: wrong val: expected: %v actual: %v", i, tc.expected, tc.actual)
+		}
+	}
+}
+
+func TestSeriesConversions(t *testing.T) {
+
+	si := NewSeriesInt64("i", nil, 1, nil, -3)
+	expectedF := NewSeriesFloat64("i", nil, 1.0, nil, -3.0)
+	if actual := si.ToSeriesFloat64(); !cmp.Equal(actual, expectedF, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expectedF, actual)
+	}
+
+	sf := NewSeriesFloat64("f", nil, 1.5, nil, -2.5, 2.7)
+
+	tests := []struct {
+		mode     RoundingMode
+		expected *SeriesInt64
+	}{
+		{RoundTruncate, NewSeriesInt64("f", nil, 1, nil, -2, 2)},
+		{RoundHalfAwayFromZero, NewSeriesInt64("f", nil, 2, nil, -3, 3)},
+		{RoundFloor, NewSeriesInt64("f", nil, 1, nil, -3, 2)},
+		{RoundCeil, NewSeriesInt64("f", nil, 2, nil, -2, 3)},
+	}
+
+	for i, tc := range tests {
+		actual, err := sf.ToSeriesInt64(tc.mode)
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+		if fmt.Sprint(actual) != fmt.Sprint(tc.expected) {
+			t.Errorf("%d: wrong val: expected: %v actual: %v", i, tc.expected, actual)
+		}
+	}
+
+	if _, err := NewSeriesFloat64("f", nil, 1e20).ToSeriesInt64(RoundTruncate); err == nil {
+		t.Errorf("expected error for out of range value")
+	}
+
+	ss := NewSeriesString("s", nil, "1.5", nil, "abc", " 2 ")
+
+	if _, err := ss.ToSeriesFloat64(); err == nil {
+		t.Errorf("expected error for unparseable value")
+	}
+
+	actual, err := ss.ToSeriesFloat64(CastOptions{NilOnError: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectedF = NewSeriesFloat64("s", nil, 1.5, nil, nil, 2.0)
+	if !cmp.Equal(actual, expectedF, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expectedF, actual)
+	}
+}
+
+func TestSeriesValuesIterator(t *testing.T) {
+
+	s := NewSeriesFloat64("f", nil, 1, nil, 3, 4, 5)
+
+	type result struct {
+		Row       int
+		Val       interface{}
+		Remaining int
+	}
+
+	collect := func(iterator func() (*int, interface{}, int)) []result {
+		out := []result{}
+		for {
+			row, val, remaining := iterator()
+			if row == nil {
+				break
+			}
+			out = append(out, result{*row, val, remaining})
+		}
+		return out
+	}
+
+	expected := []result{{0, 1.0, 4}, {1, nil, 3}, {2, 3.0, 2}, {3, 4.0, 1}, {4, 5.0, 0}}
+	if actual := collect(s.ValuesIterator()); !cmp.Equal(actual, expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual)
+	}
+
+	expected = []result{{4, 5.0, 2}, {2, 3.0, 1}, {0, 1.0, 0}}
+	if actual := collect(s.ValuesIterator(ValuesOptions{InitialRow: 4, Step: -2})); !cmp.Equal(actual, expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual)
+	}
+
+	si := NewSeriesInt64("i", nil, nil, 2, nil, 4)
+	expected = []result{{1, int64(2), 2}, {3, int64(4), 0}}
+	if actual := collect(si.ValuesIterator(ValuesOptions{SkipNil: true})); !cmp.Equal(actual, expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, actual)
+	}
+}
+
+func TestSeriesReverse(t *testing.T) {
+
+	sf := NewSeriesFloat64("f", nil, 1, nil, 3, 4)
+	sf.Reverse()
+
+	expected := NewSeriesFloat64("f", nil, 4, 3, nil, 1)
+	if !cmp.Equal(sf, expected, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, sf)
+	}
+
+	if err := sf.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	si := NewSeriesInt64("i", nil, 1, 2, nil)
+	si.Reverse()
+
+	if expected := NewSeriesInt64("i", nil, nil, 2, 1); fmt.Sprint(si) != fmt.Sprint(expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, si)
+	}
+}
+
+func TestSeriesHeadTail(t *testing.T) {
+
+	s := NewSeriesInt64("i", nil, 1, 2, nil, 4, 5)
+
+	tests := []struct {
+		actual   Series
+		expected Series
+	}{
+		{s.Head(2), NewSeriesInt64("i", nil, 1, 2)},
+		{s.Tail(3), NewSeriesInt64("i", nil, nil, 4, 5)},
+		{s.Head(10), NewSeriesInt64("i", nil, 1, 2, nil, 4, 5)},
+		{s.Tail(0), NewSeriesInt64("i", nil)},
+	}
+
+	for i, tc := range tests {
+		if fmt.Sprint(tc.actual) != fmt.Sprint(tc.expected) {
+			t.Errorf("%d: wrong val: expected: %v actual: %v", i, tc.expected, tc.actual)
+		}
+	}
+}
+
+func TestSeriesAppendSlice(t *testing.T) {
+
+	sf := NewSeriesFloat64("f", nil, 1)
+	sf.AppendSlice([]float64{2, math.NaN(), 4})
+
+	expected := NewSeriesFloat64("f", nil, 1, 2, nil, 4)
+	if !cmp.Equal(sf, expected, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, sf)
+	}
+
+	if err := sf.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	vals := []int64{2, 3}
+	si := NewSeriesInt64("i", nil, nil)
+	si.AppendSlice(vals)
+	vals[0] = 100 // Must not affect the series
+
+	if expected := NewSeriesInt64("i", nil, nil, 2, 3); fmt.Sprint(si) != fmt.Sprint(expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, si)
+	}
+
+	if err := si.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestSeriesReserve(t *testing.T) {
+
+	sf := NewSeriesFloat64("f", nil, 1, 2)
+	sf.Reserve(100)
+
+	if c := cap(sf.Values); c < 102 {
+		t.Errorf("wrong val: expected: >= %v actual: %v", 102, c)
+	}
+
+	expected := NewSeriesFloat64("f", nil, 1, 2)
+	if !cmp.Equal(sf, expected, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, sf)
+	}
+
+	si := NewSeriesInt64("i", nil, 1, nil)
+	si.Reserve(10)
+
+	if c := cap(si.values); c < 12 {
+		t.Errorf("wrong val: expected: >= %v actual: %v", 12, c)
+	}
+
+	if expected := NewSeriesInt64("i", nil, 1, nil); fmt.Sprint(si) != fmt.Sprint(expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, si)
+	}
+}
+
+func TestSeriesIsEqual(t *testing.T) {
+
+	s := NewSeriesFloat64("a", nil, 1.0, nil, 3.0)
+
+	tests := []struct {
+		other    Series
+		opts     []SeriesIsEqualOptions
+		expected bool
+	}{
+		{NewSeriesFloat64("a", nil, 1.0, 2.0, 3.0), nil, false},
+		{NewSeriesFloat64("a", nil, 1.0, nil, 3.0), nil, false}, // NaN != NaN
+		{NewSeriesFloat64("b", nil, 1.0, nil, 3.0), []SeriesIsEqualOptions{{NaNEqual: true}}, true},
+		{NewSeriesFloat64("b", nil, 1.0, nil, 3.0), []SeriesIsEqualOptions{{NaNEqual: true, CheckName: true}}, false},
+		{NewSeriesFloat64("a", nil, 1.0, nil, 3.0+1e-12), []SeriesIsEqualOptions{{NaNEqual: true}}, false},
+		{NewSeriesFloat64("a", nil, 1.0, nil, 3.0+1e-12), []SeriesIsEqualOptions{{NaNEqual: true, Epsilon: 1e-9}}, true},
+		{NewSeriesFloat64("a", nil, 1.0, nil), []SeriesIsEqualOptions{{NaNEqual: true}}, false},
+		{NewSeriesInt64("a", nil, 1, nil, 3), []SeriesIsEqualOptions{{NaNEqual: true}}, false},
+		{s, []SeriesIsEqualOptions{{NaNEqual: true}}, true},
+	}
+
+	for i, tc := range tests {
+		actual := s.IsEqual(tc.other, tc.opts...)
+		if actual != tc.expected {
+			t.Errorf("%d: wrong val: expected: %v actual: %v", i, tc.expected, actual)
+		}
+	}
+}
+
+func TestSeriesIsEqualApproxFunc(t *testing.T) {
+
+	s := NewSeriesFloat64("a", nil)
+
+	x, y := 0.1, 0.2
+
+	tests := []struct {
+		a, b     interface{}
+		epsilon  float64
+		expected bool
+	}{
+		{x + y, 0.3, 0, false},
+		{x + y, 0.3, 1e-12, true},
+		{1.0, 1.1, 1e-12, false},
+		{nil, nil, 1e-12, true},
+		{nil, 1.0, 1e-12, false},
+		{1.0, nil, 1e-12, false},
+		{math.Inf(1), math.Inf(1), 0, true},
+	}
+
+	for i, tc := range tests {
+		actual := s.IsEqualApproxFunc(tc.a, tc.b, tc.epsilon)
+		if actual != tc.expected {
+			t.Errorf("%d: wrong val: expected: %v actual: %v", i, tc.expected, actual)
+		}
+	}
+}
+
+func TestSeriesInterpolate(t *testing.T) {
+
+	base := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	times := NewSeriesTime("t", nil, base, base.Add(time.Hour), base.Add(3*time.Hour), base.Add(4*time.Hour))
+
+	tests := []struct {
+		s        *SeriesFloat64
+		method   InterpolationMethod
+		opts     []InterpolateOptions
+		expected *SeriesFloat64
+	}{
+		{
+			NewSeriesFloat64("s", nil, nil, 1.0, nil, 3.0, nil),
+			InterpolateLinear,
+			nil,
+			NewSeriesFloat64("s", nil, nil, 1.0, 2.0, 3.0, nil),
+		},
+		{
+			NewSeriesFloat64("s", nil, 0.0, nil, 3.0, nil),
+			InterpolateLinear,
+			[]InterpolateOptions{{X: times}},
+			NewSeriesFloat64("s", nil, 0.0, 1.0, 3.0, nil),
+		},
+		{
+			NewSeriesFloat64("s", nil, 0.0, nil, nil, 3.0),
+			InterpolateNearest,
+			nil,
+			NewSeriesFloat64("s", nil, 0.0, 0.0, 3.0, 3.0),
+		},
+		{
+			NewSeriesFloat64("s", nil, 0.0, nil, 3.0, 4.0),
+			InterpolateNearest,
+			[]InterpolateOptions{{X: NewSeriesInt64("x", nil, 0, 2, 3, 4)}},
+			NewSeriesFloat64("s", nil, 0.0, 3.0, 3.0, 4.0),
+		},
+		{
+			NewSeriesFloat64("s", nil, 0.0, nil, 1.0, nil, 0.0),
+			InterpolateSpline,
+			nil,
+			NewSeriesFloat64("s", nil, 0.0, 0.6875, 1.0, 0.6875, 0.0),
+		},
+		{
+			NewSeriesFloat64("s", nil, 0.0, nil, 2.0),
+			InterpolateSpline,
+			nil,
+			NewSeriesFloat64("s", nil, 0.0, 1.0, 2.0),
+		},
+	}
+
+	for i, tc := range tests {
+		err := tc.s.Interpolate(tc.method, tc.opts...)
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+
+		if !cmp.Equal(tc.s, tc.expected, cmpopts.EquateNaNs(), cmpopts.EquateApprox(0, 1e-12), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+			t.Errorf("%d: wrong val: expected: %v actual: %v", i, tc.expected, tc.s)
+		}
+
+		if err := tc.s.Validate(); err != nil {
+			t.Errorf("%d: %v", i, err)
+		}
+	}
+
+	s := NewSeriesFloat64("s", nil, 0.0, nil, 3.0)
+	if err := s.Interpolate(InterpolateLinear, InterpolateOptions{X: NewSeriesInt64("x", nil, 0, 1)}); err != ErrMismatchedRows {
+		t.Errorf("wrong val: expected: %v actual: %v", ErrMismatchedRows, err)
+	}
+	if err := s.Interpolate(InterpolateLinear, InterpolateOptions{X: NewSeriesInt64("x", nil, 0, 2, 1)}); err == nil {
+		t.Errorf("expected error for x not strictly increasing")
+	}
+	if err := s.Interpolate(InterpolateLinear, InterpolateOptions{X: NewSeriesInt64("x", nil, 0, nil, 1)}); err == nil {
+		t.Errorf("expected error for x containing nil")
+	}
+}
+
+func TestSeriesInterpolateInf(t *testing.T) {
+
+	s := NewSeriesFloat64("s", nil, math.Inf(-1), nil, math.Inf(1))
+	if err := s.Interpolate(InterpolateLinear); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Interpolating between infinite values produces NaN, so the nil value remains
+	expected := NewSeriesFloat64("s", nil, math.Inf(-1), nil, math.Inf(1))
+	if !cmp.Equal(expected, s, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, s)
+	}
+
+	// Once the Inf values are removed, the series is valid
+	s.Update(0, nil)
+	s.Update(2, nil)
+	if err := s.Validate(); err != nil {
+		t.Errorf("error encountered: %s\n", err)
+	}
+}
+
+func TestResample(t *testing.T) {
+	ctx := context.Background()
+
+	base := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	times := NewSeriesTime("t", nil, base.Add(150*time.Minute), base.Add(10*time.Minute), nil, base.Add(50*time.Minute))
+	values := NewSeriesFloat64("v", nil, 5.0, 1.0, 100.0, 3.0)
+
+	expTimes := NewSeriesTime("t", nil, base, base.Add(time.Hour), base.Add(2*time.Hour))
+
+	tests := []struct {
+		agg      AggFn
+		opts     []ResampleOptions
+		expected *SeriesFloat64
+	}{
+		{AggMean, nil, NewSeriesFloat64("v", nil, 2.0, nil, 5.0)},
+		{AggCount, nil, NewSeriesFloat64("v", nil, 2.0, nil, 1.0)},
+		{AggMax, []ResampleOptions{{FillEmpty: &LinearInterpolation}}, NewSeriesFloat64("v", nil, 3.0, 4.0, 5.0)},
+		{AggSum, []ResampleOptions{{FillEmpty: &ForwardFill}}, NewSeriesFloat64("v", nil, 4.0, 4.0, 5.0)},
+	}
+
+	for i, tc := range tests {
+		vals, ts, err := Resample(ctx, values, times, time.Hour, tc.agg, tc.opts...)
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+
+		if !cmp.Equal(vals, tc.expected, cmpopts.EquateNaNs(), cmpopts.IgnoreUnexported(SeriesFloat64{})) {
+			t.Errorf("%d: wrong val: expected: %v actual: %v", i, tc.expected, vals)
+		}
+
+		if err := vals.Validate(); err != nil {
+			t.Errorf("%d: %v", i, err)
+		}
+
+		if fmt.Sprint(ts) != fmt.Sprint(expTimes) {
+			t.Errorf("%d: wrong val: expected: %v actual: %v", i, expTimes, ts)
+		}
+	}
+
+	if _, _, err := Resample(ctx, values, times, 0, AggMean); err == nil {
+		t.Errorf("expected error for non-positive freq")
+	}
+
+	if _, _, err := Resample(ctx, values, NewSeriesTime("t", nil, base), time.Hour, AggMean); err != ErrMismatchedRows {
+		t.Errorf("wrong val: expected: %v actual: %v", ErrMismatchedRows, err)
+	}
+
+	// Too many buckets
+	if _, _, err := Resample(ctx, values, times, time.Hour, AggMean, ResampleOptions{MaxBuckets: 2}); err == nil {
+		t.Errorf("expected error for too many buckets")
+	}
+	wide := NewSeriesTime("t", nil, base, base.AddDate(1000, 0, 0))
+	if _, _, err := Resample(ctx, NewSeriesFloat64("v", nil, 1.0, 2.0), wide, time.Nanosecond, AggMean); err == nil {
+		t.Errorf("expected error for too many buckets")
+	}
+}
+
+func TestSeriesBoolSort(t *testing.T) {
+
+	// Ascending: nils first, then false, then true
+	s := NewSeriesBool("test", nil, true, nil, false, true, nil, false)
+	s.Sort()
+
+	expected := NewSeriesBool("test", nil, nil, nil, false, false, true, true)
+	if fmt.Sprint(s) != fmt.Sprint(expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, s)
+	}
+
+	// Descending: nils last
+	s.Sort(Options{SortDesc: true})
+
+	expected = NewSeriesBool("test", nil, true, true, false, false, nil, nil)
+	if fmt.Sprint(s) != fmt.Sprint(expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, s)
+	}
+
+	if err := s.Validate(); err != nil {
+		t.Errorf("error encountered: %s\n", err)
+	}
+
+	tests := []struct {
+		a, b     interface{}
+		expected bool
+	}{
+		{false, true, true},
+		{true, false, false},
+		{false, false, false},
+		{true, true, false},
+		{nil, false, true},
+		{nil, true, true},
+		{false, nil, false},
+		{true, nil, false},
+	}
+
+	for i, tc := range tests {
+		if actual := s.IsLessThanFunc(tc.a, tc.b); actual != tc.expected {
+			t.Errorf("%d: wrong val: expected: %v actual: %v", i, tc.expected, actual)
+		}
+	}
+}
+
+func TestSeriesCompare(t *testing.T) {
+
+	sf := NewSeriesFloat64("test", nil, 1.0, nil, 3.0, 4.0)
+	si := NewSeriesInt64("test", nil, 1, nil, 3, 4)
+
+	masks := []func() (*SeriesBool, error){
+		func() (*SeriesBool, error) { return sf.GreaterThan(2) },
+		func() (*SeriesBool, error) {
+			return sf.LessThanOrEqual(NewSeriesFloat64("other", nil, 1.0, 1.0, 2.0, 5.0))
+		},
+		func() (*SeriesBool, error) { return si.Equal(int64(3)) },
+		func() (*SeriesBool, error) { return si.GreaterThanOrEqual(si) },
+	}
+
+	expected := []string{
+		`[ false NaN true true ]`,
+		`[ true NaN false true ]`,
+		`[ false NaN true false ]`,
+		`[ true NaN true true ]`,
+	}
+
+	for i := range masks {
+		mask, err := masks[i]()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+
+		if mask.String() != expected[i] {
+			t.Errorf("wrong val: expected: %v actual: %v", expected[i], mask.String())
+		}
+	}
+
+	_, err := sf.Equal(NewSeriesFloat64("other", nil, 1.0))
+	if err != ErrMismatchedRows {
+		t.Errorf("expected error: %v actual: %v", ErrMismatchedRows, err)
+	}
+}
+
+func TestSeriesBoolLogic(t *testing.T) {
+
+	// Every combination of true, false and nil
+	a := NewSeriesBool("a", nil, true, true, true, false, false, false, nil, nil, nil)
+	b := NewSeriesBool("b", nil, true, false, nil, true, false, nil, true, false, nil)
+
+	and, _ := a.And(b)
+	or, _ := a.Or(b)
+	xor, _ := a.Xor(b)
+
+	actual := []string{
+		and.String(),
+		or.String(),
+		xor.String(),
+		a.Not().String(),
+	}
+
+	expected := []string{
+		`[ true false NaN ... NaN false NaN ]`,
+		`[ true true true ... true NaN NaN ]`,
+		`[ false true NaN ... NaN NaN NaN ]`,
+		`[ false false false ... NaN NaN NaN ]`,
+	}
+
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Errorf("wrong val: expected: %v actual: %v", expected[i], actual[i])
+		}
+	}
+
+	// Check middle rows which are truncated by String()
+	expectedValues := [][]interface{}{
+		{false, false, false},
+		{true, false, nil},
+		{true, false, nil},
+		{true, true, true},
+	}
+
+	for i, s := range []*SeriesBool{and, or, xor, a.Not()} {
+		for j, exp := range expectedValues[i] {
+			if !cmp.Equal(exp, s.Value(3+j)) {
+				t.Errorf("wrong val: expected: %v actual: %v", exp, s.Value(3+j))
+			}
+		}
+	}
+
+	_, err := a.And(NewSeriesBool("c", nil, true))
+	if err != ErrMismatchedRows {
+		t.Errorf("expected error: %v actual: %v", ErrMismatchedRows, err)
+	}
+}
+
+func TestSeriesTimeComponents(t *testing.T) {
+
+	tRef := time.Date(2017, 3, 4, 5, 30, 12, 0, time.UTC) // Saturday
+
+	s := NewSeriesTime("test", nil, tRef, nil)
+
+	actual := []*SeriesInt64{
+		s.Year(),
+		s.Month(),
+		s.Day(),
+		s.Hour(),
+		s.Weekday(),
+		s.DayOfYear(),
+	}
+
+	expected := []interface{}{
+		int64(2017),
+		int64(3),
+		int64(4),
+		int64(5),
+		int64(6),
+		int64(63),
+	}
+
+	for i := range actual {
+		if !cmp.Equal(expected[i], actual[i].Value(0)) {
+			t.Errorf("wrong val: expected: %v actual: %v", expected[i], actual[i].Value(0))
+		}
+
+		if actual[i].Value(1) != nil {
+			t.Errorf("wrong val: expected: %v actual: %v", nil, actual[i].Value(1))
+		}
+	}
+
+	truncated := s.Truncate(time.Hour)
+	if !cmp.Equal(time.Date(2017, 3, 4, 5, 0, 0, 0, time.UTC), truncated.Value(0)) {
+		t.Errorf("wrong val: expected: %v actual: %v", time.Date(2017, 3, 4, 5, 0, 0, 0, time.UTC), truncated.Value(0))
+	}
+}
+
+func TestSeriesTimeFloorTo(t *testing.T) {
+
+	tRef := time.Date(2017, 8, 19, 5, 30, 12, 0, time.UTC) // Saturday
+
+	s := NewSeriesTime("test", nil, tRef, nil)
+
+	units := []CalendarUnit{
+		CalendarDay,
+		CalendarWeek,
+		CalendarMonth,
+		CalendarQuarter,
+		CalendarYear,
+	}
+
+	expected := []time.Time{
+		time.Date(2017, 8, 19, 0, 0, 0, 0, time.UTC),
+		time.Date(2017, 8, 14, 0, 0, 0, 0, time.UTC),
+		time.Date(2017, 8, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2017, 7, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	for i, unit := range units {
+		floored := s.FloorTo(unit)
+
+		if !cmp.Equal(expected[i], floored.Value(0)) {
+			t.Errorf("wrong val: expected: %v actual: %v", expected[i], floored.Value(0))
+		}
+
+		if floored.Value(1) != nil {
+			t.Errorf("wrong val: expected: %v actual: %v", nil, floored.Value(1))
+		}
+	}
+}
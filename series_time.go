@@ -22,7 +22,8 @@ type SeriesTime struct {
 	nilCount int
 }
 
-// NewSeriesTime creates a new series with the underlying type as time.Time
+// NewSeriesTime creates a new series with the underlying type as time.Time.
+// String values are parsed using the RFC3339 format.
 func NewSeriesTime(name string, init *SeriesInit, vals ...interface{}) *SeriesTime {
 	s := &SeriesTime{
 		name:     name,
@@ -44,7 +45,7 @@ func NewSeriesTime(name string, init *SeriesInit, vals ...interface{}) *SeriesTi
 	}
 
 	s.values = make([]*time.Time, size, capacity)
-	s.valFormatter = DefaultValueFormatter
+	s.valFormatter = timeValueFormatter
 
 	for idx, v := range vals {
 		val := s.valToPointer(v)
@@ -114,6 +115,21 @@ func (s *SeriesTime) Value(row int, options ...Options) interface{} {
 	return *val
 }
 
+// TimeAt returns the value of a particular row without boxing it in an interface{}.
+// ok is false if the value is nil, in which case the zero value is returned.
+func (s *SeriesTime) TimeAt(row int, options ...Options) (val time.Time, ok bool) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	v := s.values[row]
+	if v == nil {
+		return time.Time{}, false
+	}
+	return *v, true
+}
+
 // ValueString returns a string representation of a
 // particular row. The string representation is defined
 // by the function set in SetValueToStringFormatter.
@@ -138,6 +154,9 @@ func (s *SeriesTime) Prepend(val interface{}, options ...Options) {
 		s.values = s.values[:len(s.values)+1]
 		copy(s.values[1:], s.values)
 		s.values[0] = s.valToPointer(val)
+		if s.values[0] == nil {
+			s.nilCount++
+		}
 		return
 	}
 
@@ -220,6 +239,62 @@ func (s *SeriesTime) Remove(row int, options ...Options) {
 	s.values = append(s.values[:row], s.values[row+1:]...)
 }
 
+// RemoveRows is used to delete the values of multiple rows in a single pass.
+// rows does not need to be sorted. An error is returned (without modifying the series)
+// if rows contains a duplicate or out of range row.
+func (s *SeriesTime) RemoveRows(rows []int, options ...Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	sorted, err := sortedRows(rows, len(s.values))
+	if err != nil {
+		return err
+	}
+
+	var j, k int
+	for i, v := range s.values {
+		if k < len(sorted) && sorted[k] == i {
+			if v == nil {
+				s.nilCount--
+			}
+			k++
+			continue
+		}
+		s.values[j] = v
+		j++
+	}
+
+	// Release references held by the unused portion of the underlying slice
+	for i := j; i < len(s.values); i++ {
+		s.values[i] = nil
+	}
+
+	s.values = s.values[:j]
+
+	return nil
+}
+
+// TrimCapacity will reallocate the underlying slice so that its capacity
+// matches the number of rows. Removing rows does not release the memory
+// held by the underlying slice, so this should be called after many rows
+// have been removed.
+func (s *SeriesTime) TrimCapacity(options ...Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	if cap(s.values) == len(s.values) {
+		return
+	}
+
+	newSlice := make([]*time.Time, len(s.values))
+	copy(newSlice, s.values)
+	s.values = newSlice
+}
+
 // Update is used to update the value of a particular row.
 // val can be a concrete data type or nil. Nil represents
 // the absence of a value.
@@ -251,6 +326,12 @@ func (s *SeriesTime) valToPointer(v interface{}) *time.Time {
 		return &[]time.Time{*val}[0]
 	case time.Time:
 		return &val
+	case string:
+		t, err := time.Parse(time.RFC3339, val)
+		if err != nil {
+			panic(err)
+		}
+		return &t
 	default:
 		_ = v.(time.Time) // Intentionally panic
 		return nil
@@ -259,10 +340,10 @@ func (s *SeriesTime) valToPointer(v interface{}) *time.Time {
 
 // SetValueToStringFormatter is used to set a function
 // to convert the value of a particular row to a string
-// representation.
+// representation. By default, values are formatted using RFC3339.
 func (s *SeriesTime) SetValueToStringFormatter(f ValueToStringFormatter) {
 	if f == nil {
-		s.valFormatter = DefaultValueFormatter
+		s.valFormatter = timeValueFormatter
 		return
 	}
 	s.valFormatter = f
@@ -363,6 +444,52 @@ func (s *SeriesTime) Sort(options ...Options) {
 	})
 }
 
+// SortByKey reorders the series based on the sorted order of keys (eg. an external timestamp slice).
+// keys must contain the same number of values as the series. NaN keys are treated as nil.
+// The sort is stable. The SortDesc option is honored.
+func (s *SeriesTime) SortByKey(keys []float64, options ...Options) error {
+	return s.sortByKey(len(keys), float64KeyLess(keys), options...)
+}
+
+// SortByKeyInt64 is the same as SortByKey except keys are int64.
+func (s *SeriesTime) SortByKeyInt64(keys []int64, options ...Options) error {
+	return s.sortByKey(len(keys), int64KeyLess(keys), options...)
+}
+
+// SortByKeyGeneric is the same as SortByKey except keys can be of any type.
+// less is used to compare keys.
+func (s *SeriesTime) SortByKeyGeneric(keys []interface{}, less IsLessThanFunc, options ...Options) error {
+	return s.sortByKey(len(keys), genericKeyLess(keys, less), options...)
+}
+
+func (s *SeriesTime) sortByKey(n int, less func(i, j int) bool, options ...Options) error {
+
+	var sortDesc bool
+
+	if len(options) == 0 {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	} else {
+		if !options[0].DontLock {
+			s.lock.Lock()
+			defer s.lock.Unlock()
+		}
+		sortDesc = options[0].SortDesc
+	}
+
+	if n != len(s.values) {
+		return ErrMismatchedRows
+	}
+
+	newVals := make([]*time.Time, 0, len(s.values))
+	for _, row := range keyOrder(n, less, sortDesc) {
+		newVals = append(newVals, s.values[row])
+	}
+	s.values = newVals
+
+	return nil
+}
+
 // Lock will lock the Series allowing you to directly manipulate
 // the underlying slice with confidence.
 func (s *SeriesTime) Lock() {
@@ -401,12 +528,41 @@ func (s *SeriesTime) Copy(r ...Range) Series {
 	x := s.values[start : end+1]
 	newSlice := append(x[:0:0], x...)
 
+	var nilCount int
+	for _, v := range newSlice {
+		if v == nil {
+			nilCount++
+		}
+	}
+
 	return &SeriesTime{
 		valFormatter: s.valFormatter,
 		name:         s.name,
 		values:       newSlice,
-		nilCount:     s.nilCount,
+		nilCount:     nilCount,
+	}
+}
+
+// Head returns a copy of the first n rows.
+// If n is larger than the number of rows, all rows are returned.
+func (s *SeriesTime) Head(n int, options ...Options) Series {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
 	}
+
+	return headTail(s, n, false)
+}
+
+// Tail returns a copy of the last n rows.
+// If n is larger than the number of rows, all rows are returned.
+func (s *SeriesTime) Tail(n int, options ...Options) Series {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	return headTail(s, n, true)
 }
 
 // Table will produce the Series in a table.
@@ -485,3 +641,192 @@ func (s *SeriesTime) ContainsNil() bool {
 	defer s.lock.RUnlock()
 	return s.nilCount > 0
 }
+
+// IsNull returns a mask containing true for each row with a nil value and false otherwise.
+// The mask can be combined with other masks and used with FilterByMask.
+func (s *SeriesTime) IsNull(options ...Options) *SeriesBool {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	mask := NewSeriesBool(s.name, &SeriesInit{Capacity: len(s.values)})
+	for _, v := range s.values {
+		mask.Append(v == nil)
+	}
+
+	return mask
+}
+
+// Validate recomputes the number of nil values and compares it against the
+// cached count, returning an error if they differ.
+// It is intended to be used as a self-check while debugging and in tests.
+func (s *SeriesTime) Validate(options ...Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	var nilCount int
+	for _, v := range s.values {
+		if v == nil {
+			nilCount++
+		}
+	}
+
+	if nilCount != s.nilCount {
+		return fmt.Errorf("nil count mismatch: cached: %d actual: %d", s.nilCount, nilCount)
+	}
+
+	return nil
+}
+
+// ForEach calls fn for each row of the series in order. val is nil for missing values.
+// Iteration stops as soon as fn returns an error, which is then returned by ForEach.
+// The series is read locked once for the duration of the iteration, so fn must not
+// modify the series.
+func (s *SeriesTime) ForEach(fn func(row int, val interface{}) error, options ...Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	for row := range s.values {
+		if err := fn(row, s.Value(row, Options{true, false})); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Year returns a new series containing the year of each row.
+// Nil values remain nil.
+func (s *SeriesTime) Year() *SeriesInt64 {
+	return s.component(func(t time.Time) int64 { return int64(t.Year()) })
+}
+
+// Month returns a new series containing the month (1-12) of each row.
+// Nil values remain nil.
+func (s *SeriesTime) Month() *SeriesInt64 {
+	return s.component(func(t time.Time) int64 { return int64(t.Month()) })
+}
+
+// Day returns a new series containing the day of the month of each row.
+// Nil values remain nil.
+func (s *SeriesTime) Day() *SeriesInt64 {
+	return s.component(func(t time.Time) int64 { return int64(t.Day()) })
+}
+
+// Hour returns a new series containing the hour (0-23) of each row.
+// Nil values remain nil.
+func (s *SeriesTime) Hour() *SeriesInt64 {
+	return s.component(func(t time.Time) int64 { return int64(t.Hour()) })
+}
+
+// Weekday returns a new series containing the day of the week of each row.
+// Sunday is 0 and Saturday is 6. Nil values remain nil.
+func (s *SeriesTime) Weekday() *SeriesInt64 {
+	return s.component(func(t time.Time) int64 { return int64(t.Weekday()) })
+}
+
+// DayOfYear returns a new series containing the day of the year (1-366) of each row.
+// Nil values remain nil.
+func (s *SeriesTime) DayOfYear() *SeriesInt64 {
+	return s.component(func(t time.Time) int64 { return int64(t.YearDay()) })
+}
+
+func (s *SeriesTime) component(fn func(t time.Time) int64) *SeriesInt64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	ns := NewSeriesInt64(s.name, &SeriesInit{Capacity: len(s.values)})
+	for _, v := range s.values {
+		if v == nil {
+			ns.Append(nil)
+			continue
+		}
+		ns.Append(fn(*v))
+	}
+
+	return ns
+}
+
+// Truncate returns a new series where each row is rounded down to a multiple of d.
+// Nil values remain nil.
+//
+// See: https://golang.org/pkg/time/#Time.Truncate
+func (s *SeriesTime) Truncate(d time.Duration) *SeriesTime {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	ns := NewSeriesTime(s.name, &SeriesInit{Capacity: len(s.values)})
+	ns.valFormatter = s.valFormatter
+	for _, v := range s.values {
+		if v == nil {
+			ns.Append(nil)
+			continue
+		}
+		ns.Append(v.Truncate(d))
+	}
+
+	return ns
+}
+
+// CalendarUnit represents a calendar period.
+type CalendarUnit int
+
+const (
+	// CalendarDay represents a calendar day.
+	CalendarDay CalendarUnit = iota
+	// CalendarWeek represents a calendar week starting on Monday (ISO 8601).
+	CalendarWeek
+	// CalendarMonth represents a calendar month.
+	CalendarMonth
+	// CalendarQuarter represents a calendar quarter starting in January, April, July or October.
+	CalendarQuarter
+	// CalendarYear represents a calendar year.
+	CalendarYear
+)
+
+// FloorTo returns a new series where each row is rounded down to the start of
+// its calendar period. Unlike Truncate, this correctly handles periods of variable
+// length such as months. The location of each value is preserved.
+// Nil values remain nil.
+func (s *SeriesTime) FloorTo(unit CalendarUnit) *SeriesTime {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	ns := NewSeriesTime(s.name, &SeriesInit{Capacity: len(s.values)})
+	ns.valFormatter = s.valFormatter
+	for _, v := range s.values {
+		if v == nil {
+			ns.Append(nil)
+			continue
+		}
+		ns.Append(floorTo(*v, unit))
+	}
+
+	return ns
+}
+
+func floorTo(t time.Time, unit CalendarUnit) time.Time {
+	y, m, d := t.Date()
+	loc := t.Location()
+
+	switch unit {
+	case CalendarDay:
+		return time.Date(y, m, d, 0, 0, 0, 0, loc)
+	case CalendarWeek:
+		offset := (int(t.Weekday()) + 6) % 7 // days since Monday
+		return time.Date(y, m, d-offset, 0, 0, 0, 0, loc)
+	case CalendarMonth:
+		return time.Date(y, m, 1, 0, 0, 0, 0, loc)
+	case CalendarQuarter:
+		return time.Date(y, ((m-1)/3)*3+1, 1, 0, 0, 0, 0, loc)
+	case CalendarYear:
+		return time.Date(y, time.January, 1, 0, 0, 0, 0, loc)
+	default:
+		panic("unknown calendar unit")
+	}
+}
@@ -26,6 +26,12 @@ func checkConcreteType(ct interface{}) error {
 		if !reflect.DeepEqual(ct, reflect.Zero(reflect.TypeOf(ct)).Interface()) {
 			return fmt.Errorf("%v is not the zero value", ct)
 		}
+	case reflect.Slice:
+		// Slices can be stored (eg. the result of collecting values).
+		// The zero value of a slice is nil.
+		if !s.IsNil() {
+			return fmt.Errorf("%v is not the zero value", ct)
+		}
 	default:
 		return fmt.Errorf("%T is not a valid concrete type", ct)
 	}
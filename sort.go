@@ -3,6 +3,8 @@
 package dataframe
 
 import (
+	"context"
+	"errors"
 	"sort"
 )
 
@@ -12,7 +14,9 @@ type IsEqualFunc func(a, b interface{}) bool
 // IsLessThanFunc returns true if a < b
 type IsLessThanFunc func(a, b interface{}) bool
 
-// SortKey is the key to sort a dataframe
+// SortKey is the key to sort a dataframe.
+// When multiple SortKeys are provided, later keys are
+// used as tiebreakers for earlier keys.
 type SortKey struct {
 
 	// Key can be an int (position of series) or string (name of series)
@@ -20,83 +24,150 @@ type SortKey struct {
 
 	// Sort in descending order
 	SortDesc bool
-
-	seriesIndex int
 }
 
-type sorter struct {
-	keys []SortKey
-	df   *DataFrame
-}
+// Sort is used to sort the data according to different keys.
+// Keys are compared in order. When two rows are equal for a given key,
+// the next key acts as the tiebreaker. Rows that are equal for every key
+// retain their relative order (stable sort).
+//
+// The result is therefore deterministic and independent of how the
+// dataframe was built, provided the full list of keys uniquely
+// identifies each row (eg. the last key is a unique id column).
+//
+// The order of the rows is determined before any row is moved.
+// If ctx is cancelled, an error is returned and the dataframe is not modified.
+//
+// Example:
+//
+//  sks := []dataframe.SortKey{
+//     {Key: "date"},
+//     {Key: "amount", SortDesc: true},
+//  }
+//
+//  err := df.Sort(ctx, sks)
+//
+func (df *DataFrame) Sort(ctx context.Context, keys []SortKey) error {
+	if len(keys) == 0 {
+		return nil
+	}
 
-func (s *sorter) Len() int {
-	return s.df.n
-}
+	df.lock.Lock()
+	defer df.lock.Unlock()
 
-func (s *sorter) Less(i, j int) bool {
+	// Convert keys to index
+	seriesIndexes := make([]int, len(keys))
+	for i, key := range keys {
+		name, ok := key.Key.(string)
+		if ok {
+			col, err := df.NameToColumn(name)
+			if err != nil {
+				return errors.New(err.Error() + ": " + name)
+			}
+			seriesIndexes[i] = col
+		} else {
+			col := key.Key.(int)
+			if col < 0 || col >= len(df.Series) {
+				return errors.New("index out of range")
+			}
+			seriesIndexes[i] = col
+		}
+	}
+
+	var ctxErr error
+
+	less := func(i, j int) bool {
+		if ctxErr != nil {
+			return false
+		}
+		if err := ctx.Err(); err != nil {
+			ctxErr = err
+			return false
+		}
 
-	for _, key := range s.keys {
-		// key.SortDesc
-		series := s.df.Series[key.seriesIndex]
+		for k, key := range keys {
+			series := df.Series[seriesIndexes[k]]
 
-		left := series.Value(i)
-		right := series.Value(j)
+			left := series.Value(i, DontLock)
+			right := series.Value(j, DontLock)
+
+			// Check if left and right are equal
+			if series.IsEqualFunc(left, right) {
+				continue
+			}
 
-		// Check if left and right are equal
-		if series.IsEqualFunc(left, right) {
-			continue
-		} else {
 			if key.SortDesc {
 				// Sort in descending order
 				return !series.IsLessThanFunc(left, right)
 			}
 			return series.IsLessThanFunc(left, right)
 		}
+
+		return false
+	}
+
+	order := make([]int, df.n)
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return less(order[i], order[j])
+	})
+
+	if ctxErr != nil {
+		return ctxErr
+	}
+
+	// Move the rows into place. Row i must contain the original row order[i].
+	// Earlier swaps may have moved the original row, so follow it to its current position.
+	for i := range order {
+		j := order[i]
+		for j < i {
+			j = order[j]
+		}
+		df.Swap(i, j, DontLock)
 	}
 
-	return false
+	return nil
 }
 
-func (s *sorter) Swap(i, j int) {
-	s.df.Swap(i, j, DontLock)
-}
+// keyOrder returns the order of rows that (stably) sorts n keys based on less.
+func keyOrder(n int, less func(i, j int) bool, sortDesc bool) []int {
 
-// Sort is used to sort the data according to different keys
-func (df *DataFrame) Sort(keys []SortKey) {
-	if len(keys) == 0 {
-		return
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
 	}
 
-	df.lock.Lock()
-	defer df.lock.Unlock()
+	sort.SliceStable(order, func(i, j int) bool {
+		if sortDesc {
+			return less(order[j], order[i])
+		}
+		return less(order[i], order[j])
+	})
 
-	// Convert keys to index
-	for i := range keys {
-		key := &keys[i]
+	return order
+}
 
-		name, ok := key.Key.(string)
-		if ok {
-			col, err := df.NameToColumn(name)
-			if err != nil {
-				panic(err)
-			}
-			key.seriesIndex = col
-		} else {
-			key.seriesIndex = key.Key.(int)
+// float64KeyLess treats NaN keys as nil values, which are sorted first.
+func float64KeyLess(keys []float64) func(i, j int) bool {
+	return func(i, j int) bool {
+		if isNaN(keys[i]) || isNaN(keys[j]) {
+			return isNaN(keys[i]) && !isNaN(keys[j])
 		}
+		return keys[i] < keys[j]
 	}
+}
 
-	s := &sorter{
-		keys: keys,
-		df:   df,
+func int64KeyLess(keys []int64) func(i, j int) bool {
+	return func(i, j int) bool {
+		return keys[i] < keys[j]
 	}
+}
 
-	sort.Stable(s)
-
-	// Clear seriesIndex from keys
-	for i := range keys {
-		key := &keys[i]
-		key.seriesIndex = 0
+func genericKeyLess(keys []interface{}, less IsLessThanFunc) func(i, j int) bool {
+	return func(i, j int) bool {
+		return less(keys[i], keys[j])
 	}
-
 }
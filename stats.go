@@ -0,0 +1,436 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package dataframe
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// ErrNoValues signifies that a series does not contain any non-nil values.
+var ErrNoValues = errors.New("no non-nil values")
+
+// Sum returns the sum of the non-nil values.
+// 0 is returned if the series contains no non-nil values.
+func (s *SeriesFloat64) Sum(options ...Options) float64 {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	var sum float64
+	for _, v := range s.Values {
+		if !isNaN(v) {
+			sum = sum + v
+		}
+	}
+	return sum
+}
+
+// Mean returns the mean of the non-nil values.
+// NaN is returned if the series contains no non-nil values.
+func (s *SeriesFloat64) Mean(options ...Options) float64 {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	mean, _, count := s.moments()
+	if count == 0 {
+		return math.NaN()
+	}
+	return mean
+}
+
+// Variance returns the sample variance (using the n-1 denominator) of the non-nil values.
+// NaN is returned if the series contains less than 2 non-nil values.
+func (s *SeriesFloat64) Variance(options ...Options) float64 {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	_, m2, count := s.moments()
+	if count < 2 {
+		return math.NaN()
+	}
+	return m2 / float64(count-1)
+}
+
+// StdDev returns the sample standard deviation (using the n-1 denominator) of the non-nil values.
+// NaN is returned if the series contains less than 2 non-nil values.
+func (s *SeriesFloat64) StdDev(options ...Options) float64 {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	_, m2, count := s.moments()
+	if count < 2 {
+		return math.NaN()
+	}
+	return math.Sqrt(m2 / float64(count-1))
+}
+
+// moments returns the mean, the sum of squared deviations from the mean and the
+// number of non-nil values. s must be locked before calling moments.
+//
+// See: https://en.wikipedia.org/wiki/Algorithms_for_calculating_variance#Welford's_online_algorithm
+func (s *SeriesFloat64) moments() (mean, m2 float64, count int) {
+	for _, v := range s.Values {
+		if isNaN(v) {
+			continue
+		}
+		count++
+		delta := v - mean
+		mean = mean + delta/float64(count)
+		m2 = m2 + delta*(v-mean)
+	}
+	return
+}
+
+// Min returns the smallest non-nil value.
+// ErrNoValues is returned if the series contains no non-nil values.
+func (s *SeriesFloat64) Min(options ...Options) (float64, error) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	row, err := s.argExtreme(func(a, b float64) bool { return a < b })
+	if err != nil {
+		return 0, err
+	}
+	return s.Values[row], nil
+}
+
+// Max returns the largest non-nil value.
+// ErrNoValues is returned if the series contains no non-nil values.
+func (s *SeriesFloat64) Max(options ...Options) (float64, error) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	row, err := s.argExtreme(func(a, b float64) bool { return a > b })
+	if err != nil {
+		return 0, err
+	}
+	return s.Values[row], nil
+}
+
+// ArgMin returns the row of the first occurrence of the smallest non-nil value.
+// ErrNoValues is returned if the series contains no non-nil values.
+func (s *SeriesFloat64) ArgMin(options ...Options) (int, error) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	return s.argExtreme(func(a, b float64) bool { return a < b })
+}
+
+// ArgMax returns the row of the first occurrence of the largest non-nil value.
+// ErrNoValues is returned if the series contains no non-nil values.
+func (s *SeriesFloat64) ArgMax(options ...Options) (int, error) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	return s.argExtreme(func(a, b float64) bool { return a > b })
+}
+
+// argExtreme returns the row of the first non-nil value for which
+// better returns true against all other non-nil values.
+// s must be locked before calling argExtreme.
+func (s *SeriesFloat64) argExtreme(better func(a, b float64) bool) (int, error) {
+	row := -1
+	for i, v := range s.Values {
+		if isNaN(v) {
+			continue
+		}
+		if row == -1 || better(v, s.Values[row]) {
+			row = i
+		}
+	}
+
+	if row == -1 {
+		return 0, ErrNoValues
+	}
+	return row, nil
+}
+
+// Min returns the smallest non-nil value.
+// ErrNoValues is returned if the series contains no non-nil values.
+func (s *SeriesInt64) Min(options ...Options) (int64, error) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	row, err := s.argExtreme(func(a, b int64) bool { return a < b })
+	if err != nil {
+		return 0, err
+	}
+	return *s.values[row], nil
+}
+
+// Max returns the largest non-nil value.
+// ErrNoValues is returned if the series contains no non-nil values.
+func (s *SeriesInt64) Max(options ...Options) (int64, error) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	row, err := s.argExtreme(func(a, b int64) bool { return a > b })
+	if err != nil {
+		return 0, err
+	}
+	return *s.values[row], nil
+}
+
+// ArgMin returns the row of the first occurrence of the smallest non-nil value.
+// ErrNoValues is returned if the series contains no non-nil values.
+func (s *SeriesInt64) ArgMin(options ...Options) (int, error) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	return s.argExtreme(func(a, b int64) bool { return a < b })
+}
+
+// ArgMax returns the row of the first occurrence of the largest non-nil value.
+// ErrNoValues is returned if the series contains no non-nil values.
+func (s *SeriesInt64) ArgMax(options ...Options) (int, error) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	return s.argExtreme(func(a, b int64) bool { return a > b })
+}
+
+// argExtreme returns the row of the first non-nil value for which
+// better returns true against all other non-nil values.
+// s must be locked before calling argExtreme.
+func (s *SeriesInt64) argExtreme(better func(a, b int64) bool) (int, error) {
+	row := -1
+	for i, v := range s.values {
+		if v == nil {
+			continue
+		}
+		if row == -1 || better(*v, *s.values[row]) {
+			row = i
+		}
+	}
+
+	if row == -1 {
+		return 0, ErrNoValues
+	}
+	return row, nil
+}
+
+// Quantile returns the q-th quantile (q between [0,1]) of the non-nil values
+// using linear interpolation between the closest ranks. The series is not modified.
+// ErrNoValues is returned if the series contains no non-nil values.
+func (s *SeriesFloat64) Quantile(q float64, options ...Options) (float64, error) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	if q < 0 || q > 1 || math.IsNaN(q) {
+		return 0, errors.New("q must be between [0,1]")
+	}
+
+	sorted := s.sorted()
+	if len(sorted) == 0 {
+		return 0, ErrNoValues
+	}
+	return quantile(sorted, q), nil
+}
+
+// Percentile returns the p-th percentile (p between [0,100]) of the non-nil values.
+// See Quantile.
+func (s *SeriesFloat64) Percentile(p float64, options ...Options) (float64, error) {
+	if p < 0 || p > 100 || math.IsNaN(p) {
+		return 0, errors.New("p must be between [0,100]")
+	}
+	return s.Quantile(p/100, options...)
+}
+
+// sorted returns a sorted copy of the non-nil values. s must be locked before calling sorted.
+func (s *SeriesFloat64) sorted() []float64 {
+	out := make([]float64, 0, len(s.Values))
+	for _, v := range s.Values {
+		if !isNaN(v) {
+			out = append(out, v)
+		}
+	}
+	sort.Float64s(out)
+	return out
+}
+
+// Median returns the median of the non-nil values.
+// For an even number of values, the mean of the two middle values is returned.
+// ErrNoValues is returned if the series contains no non-nil values.
+func (s *SeriesFloat64) Median(options ...Options) (float64, error) {
+	return s.Quantile(0.5, options...)
+}
+
+// Mode returns the most frequent non-nil values in ascending order.
+// All values tied for the highest frequency are returned.
+// ErrNoValues is returned if the series contains no non-nil values.
+func (s *SeriesFloat64) Mode(options ...Options) ([]float64, error) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	sorted := s.sorted()
+	if len(sorted) == 0 {
+		return nil, ErrNoValues
+	}
+
+	var (
+		modes         []float64
+		best, current int
+	)
+
+	for i, v := range sorted {
+		if i > 0 && v == sorted[i-1] {
+			current++
+		} else {
+			current = 1
+		}
+
+		if current > best {
+			best = current
+			modes = []float64{v}
+		} else if current == best {
+			modes = append(modes, v)
+		}
+	}
+	return modes, nil
+}
+
+// Median returns the median of the non-nil values.
+// For an even number of values, the mean of the two middle values is returned.
+// ErrNoValues is returned if the series contains no non-nil values.
+func (s *SeriesInt64) Median(options ...Options) (float64, error) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	sorted := s.sorted()
+	if len(sorted) == 0 {
+		return 0, ErrNoValues
+	}
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return float64(sorted[mid]), nil
+	}
+	return (float64(sorted[mid-1]) + float64(sorted[mid])) / 2, nil
+}
+
+// Mode returns the most frequent non-nil values in ascending order.
+// All values tied for the highest frequency are returned.
+// ErrNoValues is returned if the series contains no non-nil values.
+func (s *SeriesInt64) Mode(options ...Options) ([]int64, error) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	sorted := s.sorted()
+	if len(sorted) == 0 {
+		return nil, ErrNoValues
+	}
+
+	var (
+		modes         []int64
+		best, current int
+	)
+
+	for i, v := range sorted {
+		if i > 0 && v == sorted[i-1] {
+			current++
+		} else {
+			current = 1
+		}
+
+		if current > best {
+			best = current
+			modes = []int64{v}
+		} else if current == best {
+			modes = append(modes, v)
+		}
+	}
+	return modes, nil
+}
+
+// sorted returns a sorted copy of the non-nil values. s must be locked before calling sorted.
+func (s *SeriesInt64) sorted() []int64 {
+	out := make([]int64, 0, len(s.values))
+	for _, v := range s.values {
+		if v != nil {
+			out = append(out, *v)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+// Normalize returns a new series with the non-nil values scaled to [0,1] using min-max scaling.
+// If all non-nil values are equal, they are scaled to 0. Nil values remain nil.
+func (s *SeriesFloat64) Normalize(options ...Options) *SeriesFloat64 {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	var min, max float64
+	if row, err := s.argExtreme(func(a, b float64) bool { return a < b }); err == nil {
+		min = s.Values[row]
+	}
+	if row, err := s.argExtreme(func(a, b float64) bool { return a > b }); err == nil {
+		max = s.Values[row]
+	}
+
+	return s.Apply(func(val float64, row int) float64 {
+		if max == min {
+			return 0
+		}
+		return (val - min) / (max - min)
+	}, ApplyOptions{DontLock: true})
+}
+
+// Standardize returns a new series with the non-nil values scaled to have a mean of 0
+// and a sample standard deviation of 1 (z-scores). If the standard deviation is 0 or undefined,
+// the non-nil values are scaled to 0. Nil values remain nil.
+func (s *SeriesFloat64) Standardize(options ...Options) *SeriesFloat64 {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	mean, m2, count := s.moments()
+
+	var std float64
+	if count > 1 {
+		std = math.Sqrt(m2 / float64(count-1))
+	}
+
+	return s.Apply(func(val float64, row int) float64 {
+		if std == 0 {
+			return 0
+		}
+		return (val - mean) / std
+	}, ApplyOptions{DontLock: true})
+}
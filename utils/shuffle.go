@@ -1,5 +1,10 @@
 // Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
 
+// Package utils contains functions that operate on both DataFrames and Series.
+//
+// Functions that accept a *rand.Rand use it as the exclusive source of randomness.
+// The global random number generator is never used, so the same seed will always
+// produce the same results.
 package utils
 
 import (
@@ -10,14 +15,26 @@ import (
 	dataframe "github.com/rocketlaunchr/dataframe-go"
 )
 
-func init() {
-	rand.Seed(time.Now().UTC().UnixNano())
+// Shuffle will randomly shuffle the rows in a Dataframe or Series.
+// If a Range is provided, only the rows within the range are shuffled.
+// s will be locked for the duration of the operation.
+//
+// The results are not reproducible. Use ShuffleWithRand instead
+// if deterministic results are required.
+func Shuffle(ctx context.Context, s common, r ...dataframe.Range) error {
+	return ShuffleWithRand(ctx, s, rand.New(rand.NewSource(time.Now().UTC().UnixNano())), r...)
 }
 
-// Shuffle will randomly shuffle the rows in a Dataframe or Series.
+// ShuffleWithRand will randomly shuffle the rows in a Dataframe or Series using rng.
 // If a Range is provided, only the rows within the range are shuffled.
 // s will be locked for the duration of the operation.
-func Shuffle(ctx context.Context, s common, r ...dataframe.Range) (rErr error) {
+//
+// Example:
+//
+//  rng := rand.New(rand.NewSource(42))
+//  utils.ShuffleWithRand(ctx, df, rng)
+//
+func ShuffleWithRand(ctx context.Context, s common, rng *rand.Rand, r ...dataframe.Range) (rErr error) {
 
 	defer func() {
 		if x := recover(); x != nil {
@@ -48,7 +65,7 @@ func Shuffle(ctx context.Context, s common, r ...dataframe.Range) (rErr error) {
 		return nil
 	}
 
-	rand.Shuffle(rRows, func(i, j int) {
+	rng.Shuffle(rRows, func(i, j int) {
 		if err := ctx.Err(); err != nil {
 			panic(err)
 		}
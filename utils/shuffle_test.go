@@ -0,0 +1,52 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	dataframe "github.com/rocketlaunchr/dataframe-go"
+)
+
+func TestShuffleWithRand(t *testing.T) {
+	ctx := context.Background()
+
+	shuffle := func(seed int64, r ...dataframe.Range) *dataframe.DataFrame {
+		df := dataframe.NewDataFrame(
+			dataframe.NewSeriesInt64("id", nil, 1, 2, 3, 4, 5, 6, 7, 8),
+			dataframe.NewSeriesString("s", nil, "a", "b", "c", "d", "e", "f", "g", "h"),
+		)
+		if err := ShuffleWithRand(ctx, df, rand.New(rand.NewSource(seed)), r...); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return df
+	}
+
+	// The same seed produces the same order
+	expected := shuffle(42)
+	for i := 0; i < 3; i++ {
+		actual := shuffle(42)
+		if eq, _ := actual.IsEqual(expected); !eq {
+			t.Errorf("wrong val: expected: %v actual: %v", expected.Table(), actual.Table())
+		}
+	}
+
+	// Rows stay aligned across series
+	id, s := expected.Series[0], expected.Series[1]
+	for row := 0; row < id.NRows(); row++ {
+		if fmt.Sprint(s.Value(row)) != string(rune('a'+id.Value(row).(int64)-1)) {
+			t.Errorf("wrong val: row %d is misaligned: %v %v", row, id.Value(row), s.Value(row))
+		}
+	}
+
+	// Rows outside the range are untouched
+	actual := shuffle(7, dataframe.Range{Start: &[]int{2}[0], End: &[]int{5}[0]})
+	for _, row := range []int{0, 1, 6, 7} {
+		if v := actual.Series[0].Value(row); v != int64(row+1) {
+			t.Errorf("wrong val: expected: %v actual: %v", row+1, v)
+		}
+	}
+}
@@ -146,6 +146,9 @@ func (s *SeriesComplex128) Prepend(val interface{}, options ...dataframe.Options
 		s.Values = s.Values[:len(s.Values)+1]
 		copy(s.Values[1:], s.Values)
 		s.Values[0] = s.valToPointer(val)
+		if cmplx.IsNaN(s.Values[0]) {
+			s.nilCount++
+		}
 		return
 	}
 
@@ -220,6 +223,25 @@ func (s *SeriesComplex128) Remove(row int, options ...dataframe.Options) {
 	s.Values = append(s.Values[:row], s.Values[row+1:]...)
 }
 
+// TrimCapacity will reallocate the underlying slice so that its capacity
+// matches the number of rows. Removing rows does not release the memory
+// held by the underlying slice, so this should be called after many rows
+// have been removed.
+func (s *SeriesComplex128) TrimCapacity(options ...dataframe.Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	if cap(s.Values) == len(s.Values) {
+		return
+	}
+
+	newSlice := make([]complex128, len(s.Values))
+	copy(newSlice, s.Values)
+	s.Values = newSlice
+}
+
 // Update is used to update the value of a particular row.
 // val can be a concrete data type or nil. Nil represents
 // the absence of a value.
@@ -401,11 +423,18 @@ func (s *SeriesComplex128) Copy(r ...dataframe.Range) dataframe.Series {
 	x := s.Values[start : end+1]
 	newSlice := append(x[:0:0], x...)
 
+	var nilCount int
+	for _, v := range newSlice {
+		if cmplx.IsNaN(v) {
+			nilCount++
+		}
+	}
+
 	return &SeriesComplex128{
 		valFormatter: s.valFormatter,
 		name:         s.name,
 		Values:       newSlice,
-		nilCount:     s.nilCount,
+		nilCount:     nilCount,
 	}
 }
 
@@ -487,6 +516,67 @@ func (s *SeriesComplex128) ContainsNil() bool {
 	return s.nilCount > 0
 }
 
+// IsNull returns a mask containing true for each row with a nil value and false otherwise.
+// The mask can be combined with other masks and used with dataframe.FilterByMask.
+func (s *SeriesComplex128) IsNull(options ...dataframe.Options) *dataframe.SeriesBool {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	mask := dataframe.NewSeriesBool(s.name, &dataframe.SeriesInit{Capacity: len(s.Values)})
+	for _, v := range s.Values {
+		mask.Append(cmplx.IsNaN(v))
+	}
+
+	return mask
+}
+
+// Validate recomputes the number of nil values and compares it against the
+// cached count, returning an error if they differ. An error is also returned
+// if the series contains an Inf value.
+// It is intended to be used as a self-check while debugging and in tests.
+func (s *SeriesComplex128) Validate(options ...dataframe.Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	var nilCount int
+	for row, v := range s.Values {
+		if cmplx.IsNaN(v) {
+			nilCount++
+		} else if cmplx.IsInf(v) {
+			return fmt.Errorf("row %d contains an Inf value", row)
+		}
+	}
+
+	if nilCount != s.nilCount {
+		return fmt.Errorf("nil count mismatch: cached: %d actual: %d", s.nilCount, nilCount)
+	}
+
+	return nil
+}
+
+// ForEach calls fn for each row of the series in order. val is nil for missing values.
+// Iteration stops as soon as fn returns an error, which is then returned by ForEach.
+// The series is read locked once for the duration of the iteration, so fn must not
+// modify the series.
+func (s *SeriesComplex128) ForEach(fn func(row int, val interface{}) error, options ...dataframe.Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	for row := range s.Values {
+		if err := fn(row, s.Value(row, dataframe.Options{true, false})); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // DefaultValueFormatter will return a string representation
 // of the data in a particular row.
 func DefaultValueFormatter(v interface{}) string {
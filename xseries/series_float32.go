@@ -0,0 +1,636 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package xseries
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/olekukonko/tablewriter"
+	dataframe "github.com/rocketlaunchr/dataframe-go"
+)
+
+// SeriesFloat32 is used for series containing float32 data.
+// It uses half the memory of dataframe.SeriesFloat64 and is suitable for
+// large datasets that don't require double precision. Like SeriesFloat64,
+// NaN represents a nil value.
+type SeriesFloat32 struct {
+	valFormatter dataframe.ValueToStringFormatter
+
+	lock sync.RWMutex
+	name string
+	// Values is exported to better improve interoperability with the gonum package.
+	// See: https://godoc.org/gonum.org/v1/gonum
+	Values   []float32
+	nilCount int
+}
+
+// NewSeriesFloat32 creates a new series with the underlying type as float32
+func NewSeriesFloat32(name string, init *dataframe.SeriesInit, vals ...interface{}) *SeriesFloat32 {
+	s := &SeriesFloat32{
+		name:     name,
+		Values:   []float32{},
+		nilCount: 0,
+	}
+
+	var (
+		size     int
+		capacity int
+	)
+
+	if init != nil {
+		size = init.Size
+		capacity = init.Capacity
+		if size > capacity {
+			capacity = size
+		}
+	}
+
+	s.Values = make([]float32, size, capacity) // Warning: filled with 0.0 (not NaN)
+	s.valFormatter = dataframe.DefaultValueFormatter
+
+	for idx, v := range vals {
+		val := s.valToPointer(v)
+		if isNaN32(val) {
+			s.nilCount++
+		}
+
+		if idx < size {
+			s.Values[idx] = val
+		} else {
+			s.Values = append(s.Values, val)
+		}
+	}
+
+	if len(vals) < size {
+		s.nilCount = s.nilCount + size - len(vals)
+		// Fill with NaN
+		for i := len(vals); i < size; i++ {
+			s.Values[i] = nan32()
+		}
+	}
+
+	return s
+}
+
+// Name returns the series name.
+func (s *SeriesFloat32) Name() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.name
+}
+
+// Rename renames the series.
+func (s *SeriesFloat32) Rename(n string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.name = n
+}
+
+// Type returns the type of data the series holds.
+func (s *SeriesFloat32) Type() string {
+	return "float32"
+}
+
+// NRows returns how many rows the series contains.
+func (s *SeriesFloat32) NRows(options ...dataframe.Options) int {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	return len(s.Values)
+}
+
+// Value returns the value of a particular row.
+// The return value could be nil or the concrete type
+// the data type held by the series.
+// Pointers are never returned.
+func (s *SeriesFloat32) Value(row int, options ...dataframe.Options) interface{} {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	val := s.Values[row]
+	if isNaN32(val) {
+		return nil
+	}
+	return val
+}
+
+// ValueString returns a string representation of a
+// particular row. The string representation is defined
+// by the function set in SetValueToStringFormatter.
+// By default, a nil value is returned as "NaN".
+func (s *SeriesFloat32) ValueString(row int, options ...dataframe.Options) string {
+	return s.valFormatter(s.Value(row, options...))
+}
+
+// Prepend is used to set a value to the beginning of the
+// series. val can be a concrete data type or nil. Nil
+// represents the absence of a value.
+func (s *SeriesFloat32) Prepend(val interface{}, options ...dataframe.Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	// See: https://stackoverflow.com/questions/41914386/what-is-the-mechanism-of-using-append-to-prepend-in-go
+
+	if cap(s.Values) > len(s.Values) {
+		// There is already extra capacity so copy current values by 1 spot
+		s.Values = s.Values[:len(s.Values)+1]
+		copy(s.Values[1:], s.Values)
+		s.Values[0] = s.valToPointer(val)
+		if isNaN32(s.Values[0]) {
+			s.nilCount++
+		}
+		return
+	}
+
+	// No room, new slice needs to be allocated:
+	s.insert(0, val)
+}
+
+// Append is used to set a value to the end of the series.
+// val can be a concrete data type or nil. Nil represents
+// the absence of a value.
+func (s *SeriesFloat32) Append(val interface{}, options ...dataframe.Options) int {
+	var locked bool
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		locked = true
+	}
+
+	row := s.NRows(dataframe.Options{DontLock: locked})
+	s.insert(row, val)
+	return row
+}
+
+// Insert is used to set a value at an arbitrary row in
+// the series. All existing values from that row onwards
+// are shifted by 1. val can be a concrete data type or nil.
+// Nil represents the absence of a value.
+func (s *SeriesFloat32) Insert(row int, val interface{}, options ...dataframe.Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	s.insert(row, val)
+}
+
+func (s *SeriesFloat32) insert(row int, val interface{}) {
+	switch V := val.(type) {
+	case []float32:
+		// count how many NaN
+		for _, v := range V {
+			if isNaN32(v) {
+				s.nilCount++
+			}
+		}
+		s.Values = append(s.Values[:row], append(V, s.Values[row:]...)...)
+		return
+	}
+
+	s.Values = append(s.Values, nan32())
+	copy(s.Values[row+1:], s.Values[row:])
+
+	v := s.valToPointer(val)
+	if isNaN32(v) {
+		s.nilCount++
+	}
+
+	s.Values[row] = v
+}
+
+// Remove is used to delete the value of a particular row.
+func (s *SeriesFloat32) Remove(row int, options ...dataframe.Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	if isNaN32(s.Values[row]) {
+		s.nilCount--
+	}
+
+	s.Values = append(s.Values[:row], s.Values[row+1:]...)
+}
+
+// TrimCapacity will reallocate the underlying slice so that its capacity
+// matches the number of rows. Removing rows does not release the memory
+// held by the underlying slice, so this should be called after many rows
+// have been removed.
+func (s *SeriesFloat32) TrimCapacity(options ...dataframe.Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	if cap(s.Values) == len(s.Values) {
+		return
+	}
+
+	newSlice := make([]float32, len(s.Values))
+	copy(newSlice, s.Values)
+	s.Values = newSlice
+}
+
+// Update is used to update the value of a particular row.
+// val can be a concrete data type or nil. Nil represents
+// the absence of a value.
+func (s *SeriesFloat32) Update(row int, val interface{}, options ...dataframe.Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	newVal := s.valToPointer(val)
+
+	if isNaN32(s.Values[row]) && !isNaN32(newVal) {
+		s.nilCount--
+	} else if !isNaN32(s.Values[row]) && isNaN32(newVal) {
+		s.nilCount++
+	}
+
+	s.Values[row] = newVal
+}
+
+func (s *SeriesFloat32) valToPointer(v interface{}) float32 {
+	switch val := v.(type) {
+	case nil:
+		return nan32()
+	case *float32:
+		if val == nil {
+			return nan32()
+		}
+		return *val
+	case float32:
+		return val
+	default:
+		f, err := strconv.ParseFloat(fmt.Sprintf("%v", v), 32)
+		if err != nil {
+			_ = v.(float32) // Intentionally panic
+		}
+		return float32(f)
+	}
+}
+
+// SetValueToStringFormatter is used to set a function
+// to convert the value of a particular row to a string
+// representation.
+func (s *SeriesFloat32) SetValueToStringFormatter(f dataframe.ValueToStringFormatter) {
+	if f == nil {
+		s.valFormatter = dataframe.DefaultValueFormatter
+		return
+	}
+	s.valFormatter = f
+}
+
+// Swap is used to swap 2 values based on their row position.
+func (s *SeriesFloat32) Swap(row1, row2 int, options ...dataframe.Options) {
+	if row1 == row2 {
+		return
+	}
+
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	s.Values[row1], s.Values[row2] = s.Values[row2], s.Values[row1]
+}
+
+// IsEqualFunc returns true if a is equal to b.
+func (s *SeriesFloat32) IsEqualFunc(a, b interface{}) bool {
+
+	if a == nil {
+		if b == nil {
+			return true
+		}
+		return false
+	}
+
+	if b == nil {
+		return false
+	}
+	f1 := a.(float32)
+	f2 := b.(float32)
+
+	return f1 == f2
+}
+
+// IsLessThanFunc returns true if a is less than b.
+func (s *SeriesFloat32) IsLessThanFunc(a, b interface{}) bool {
+
+	if a == nil {
+		if b == nil {
+			return true
+		}
+		return true
+	}
+
+	if b == nil {
+		return false
+	}
+	f1 := a.(float32)
+	f2 := b.(float32)
+
+	return f1 < f2
+}
+
+// Sort will sort the series.
+func (s *SeriesFloat32) Sort(options ...dataframe.Options) {
+
+	var sortDesc bool
+
+	if len(options) == 0 {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	} else {
+		if !options[0].DontLock {
+			s.lock.Lock()
+			defer s.lock.Unlock()
+		}
+		sortDesc = options[0].SortDesc
+	}
+
+	sort.SliceStable(s.Values, func(i, j int) (ret bool) {
+		defer func() {
+			if sortDesc {
+				ret = !ret
+			}
+		}()
+
+		if isNaN32(s.Values[i]) {
+			if isNaN32(s.Values[j]) {
+				// both are nil
+				return true
+			}
+			return true
+		}
+
+		if isNaN32(s.Values[j]) {
+			// i has value and j is nil
+			return false
+		}
+		// Both are not nil
+		ti := s.Values[i]
+		tj := s.Values[j]
+
+		return ti < tj
+	})
+}
+
+// Lock will lock the Series allowing you to directly manipulate
+// the underlying slice with confidence.
+func (s *SeriesFloat32) Lock() {
+	s.lock.Lock()
+}
+
+// Unlock will unlock the Series that was previously locked.
+func (s *SeriesFloat32) Unlock() {
+	s.lock.Unlock()
+}
+
+// Copy will create a new copy of the series.
+// It is recommended that you lock the Series before attempting
+// to Copy.
+func (s *SeriesFloat32) Copy(r ...dataframe.Range) dataframe.Series {
+
+	if len(s.Values) == 0 {
+		return &SeriesFloat32{
+			valFormatter: s.valFormatter,
+			name:         s.name,
+			Values:       []float32{},
+			nilCount:     s.nilCount,
+		}
+	}
+
+	if len(r) == 0 {
+		r = append(r, dataframe.Range{})
+	}
+
+	start, end, err := r[0].Limits(len(s.Values))
+	if err != nil {
+		panic(err)
+	}
+
+	// Copy slice
+	x := s.Values[start : end+1]
+	newSlice := append(x[:0:0], x...)
+
+	var nilCount int
+	for _, v := range newSlice {
+		if isNaN32(v) {
+			nilCount++
+		}
+	}
+
+	return &SeriesFloat32{
+		valFormatter: s.valFormatter,
+		name:         s.name,
+		Values:       newSlice,
+		nilCount:     nilCount,
+	}
+}
+
+// Table will produce the Series in a table.
+func (s *SeriesFloat32) Table(r ...dataframe.Range) string {
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if len(r) == 0 {
+		r = append(r, dataframe.Range{})
+	}
+
+	data := [][]string{}
+
+	headers := []string{"", s.name} // row header is blank
+	footers := []string{fmt.Sprintf("%dx%d", len(s.Values), 1), s.Type()}
+
+	if len(s.Values) > 0 {
+
+		start, end, err := r[0].Limits(len(s.Values))
+		if err != nil {
+			panic(err)
+		}
+
+		for row := start; row <= end; row++ {
+			sVals := []string{fmt.Sprintf("%d:", row), s.ValueString(row, dataframe.Options{true, false})}
+			data = append(data, sVals)
+		}
+
+	}
+
+	var buf bytes.Buffer
+
+	table := tablewriter.NewWriter(&buf)
+	table.SetHeader(headers)
+	for _, v := range data {
+		table.Append(v)
+	}
+	table.SetFooter(footers)
+	table.SetAlignment(tablewriter.ALIGN_CENTER)
+
+	table.Render()
+
+	return buf.String()
+}
+
+// String implements Stringer interface.
+func (s *SeriesFloat32) String() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	count := len(s.Values)
+
+	out := "[ "
+
+	if count > 6 {
+		idx := []int{0, 1, 2, count - 3, count - 2, count - 1}
+		for j, row := range idx {
+			if j == 3 {
+				out = out + "... "
+			}
+			out = out + s.ValueString(row, dataframe.Options{true, false}) + " "
+		}
+		return out + "]"
+	}
+
+	for row := range s.Values {
+		out = out + s.ValueString(row, dataframe.Options{true, false}) + " "
+	}
+	return out + "]"
+
+}
+
+// ContainsNil will return whether or not the series contains any nil values.
+func (s *SeriesFloat32) ContainsNil() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.nilCount > 0
+}
+
+// IsNull returns a mask containing true for each row with a nil value and false otherwise.
+// The mask can be combined with other masks and used with dataframe.FilterByMask.
+func (s *SeriesFloat32) IsNull(options ...dataframe.Options) *dataframe.SeriesBool {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	mask := dataframe.NewSeriesBool(s.name, &dataframe.SeriesInit{Capacity: len(s.Values)})
+	for _, v := range s.Values {
+		mask.Append(isNaN32(v))
+	}
+
+	return mask
+}
+
+// Validate recomputes the number of nil values and compares it against the
+// cached count, returning an error if they differ. An error is also returned
+// if the series contains an Inf value.
+// It is intended to be used as a self-check while debugging and in tests.
+func (s *SeriesFloat32) Validate(options ...dataframe.Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	var nilCount int
+	for row, v := range s.Values {
+		if isNaN32(v) {
+			nilCount++
+		} else if math.IsInf(float64(v), 0) {
+			return fmt.Errorf("row %d contains an Inf value", row)
+		}
+	}
+
+	if nilCount != s.nilCount {
+		return fmt.Errorf("nil count mismatch: cached: %d actual: %d", s.nilCount, nilCount)
+	}
+
+	return nil
+}
+
+// ForEach calls fn for each row of the series in order. val is nil for missing values.
+// Iteration stops as soon as fn returns an error, which is then returned by ForEach.
+// The series is read locked once for the duration of the iteration, so fn must not
+// modify the series.
+func (s *SeriesFloat32) ForEach(fn func(row int, val interface{}) error, options ...dataframe.Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	for row := range s.Values {
+		if err := fn(row, s.Value(row, dataframe.Options{true, false})); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewSeriesFloat32FromFloat64 creates a new SeriesFloat32 containing the values of s
+// converted to float32. An error is returned if a finite value is outside the range of float32.
+// NaN (nil) and Inf values are preserved.
+func NewSeriesFloat32FromFloat64(s *dataframe.SeriesFloat64, options ...dataframe.Options) (*SeriesFloat32, error) {
+	name := s.Name()
+
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.Lock()
+		defer s.Unlock()
+	}
+
+	out := NewSeriesFloat32(name, &dataframe.SeriesInit{Capacity: len(s.Values)})
+	for row, v := range s.Values {
+		f := float32(v)
+		if math.IsInf(float64(f), 0) && !math.IsInf(v, 0) {
+			return nil, fmt.Errorf("value %v at row %d is outside the range of float32", v, row)
+		}
+		if isNaN32(f) {
+			out.nilCount++
+		}
+		out.Values = append(out.Values, f)
+	}
+
+	return out, nil
+}
+
+// ToSeriesFloat64 returns a new dataframe.SeriesFloat64 containing the values of the series.
+// NaN (nil) values are preserved.
+func (s *SeriesFloat32) ToSeriesFloat64(options ...dataframe.Options) *dataframe.SeriesFloat64 {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	vals := make([]float64, 0, len(s.Values))
+	for _, v := range s.Values {
+		vals = append(vals, float64(v))
+	}
+
+	out := dataframe.NewSeriesFloat64(s.name, nil)
+	out.AppendSlice(vals, dataframe.DontLock)
+	return out
+}
+
+func isNaN32(f float32) bool {
+	return f != f
+}
+
+func nan32() float32 {
+	return float32(math.NaN())
+}
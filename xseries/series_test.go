@@ -0,0 +1,104 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package xseries
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	dataframe "github.com/rocketlaunchr/dataframe-go"
+)
+
+func TestSeriesFloat32Conversion(t *testing.T) {
+
+	sf := dataframe.NewSeriesFloat64("test", nil, 1.5, nil, -2.25, math.Inf(1))
+
+	s, err := NewSeriesFloat32FromFloat64(sf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := NewSeriesFloat32("test", nil, float32(1.5), nil, float32(-2.25), float32(math.Inf(1)))
+	if fmt.Sprint(s) != fmt.Sprint(expected) || s.Name() != expected.Name() {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, s)
+	}
+
+	if err := s.Validate(); err == nil {
+		t.Errorf("expected error for Inf value")
+	}
+
+	// Converting back preserves the values and nils
+	back := s.ToSeriesFloat64()
+	if fmt.Sprint(back) != fmt.Sprint(sf) || back.Name() != sf.Name() {
+		t.Errorf("wrong val: expected: %v actual: %v", sf, back)
+	}
+
+	// Validate rejects Inf values
+	back.Update(3, nil)
+	if err := back.Validate(); err != nil {
+		t.Errorf("error encountered: %s\n", err)
+	}
+
+	// Finite values outside the range of float32
+	for _, v := range []float64{1e39, -1e39} {
+		_, err := NewSeriesFloat32FromFloat64(dataframe.NewSeriesFloat64("test", nil, 1.0, v))
+		if err == nil {
+			t.Errorf("expected error for %v", v)
+		}
+	}
+}
+
+func TestSeriesUint64(t *testing.T) {
+
+	s := NewSeriesUint64("test", nil, uint64(3), nil, "18446744073709551615", 1)
+	s.Append(uint64(2))
+	s.Sort()
+
+	expected := NewSeriesUint64("test", nil, nil, 1, 2, 3, uint64(math.MaxUint64))
+	if fmt.Sprint(s) != fmt.Sprint(expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, s)
+	}
+
+	if v := s.Value(4); v != uint64(math.MaxUint64) {
+		t.Errorf("wrong val: expected: %v actual: %v", uint64(math.MaxUint64), v)
+	}
+
+	if err := s.Validate(); err != nil {
+		t.Errorf("error encountered: %s\n", err)
+	}
+
+	// Negative values can't be stored
+	tests := []func(){
+		func() { NewSeriesUint64("test", nil, -1) },
+		func() { s.Append(int64(-1)) },
+		func() { s.Insert(0, -1) },
+		func() { s.Update(0, "-5") },
+	}
+
+	for i, fn := range tests {
+		func() {
+			defer func() {
+				x := recover()
+				if x == nil {
+					t.Errorf("%d: expected panic", i)
+					return
+				}
+				if err, ok := x.(error); !ok || !strings.Contains(err.Error(), "negative value") {
+					t.Errorf("%d: wrong val: expected: %v actual: %v", i, "negative value", x)
+				}
+			}()
+			fn()
+		}()
+	}
+
+	// The series is unchanged
+	if fmt.Sprint(s) != fmt.Sprint(expected) {
+		t.Errorf("wrong val: expected: %v actual: %v", expected, s)
+	}
+
+	if err := s.Validate(); err != nil {
+		t.Errorf("error encountered: %s\n", err)
+	}
+}
@@ -0,0 +1,585 @@
+// Copyright 2019 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package xseries
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/olekukonko/tablewriter"
+	dataframe "github.com/rocketlaunchr/dataframe-go"
+)
+
+// SeriesUint64 is used for series containing uint64 data.
+// It is suitable for naturally unsigned data such as auto-increment IDs
+// and byte counts, including values that exceed the range of int64.
+// Other integer types and strings are converted when set. Negative values
+// cause a panic.
+type SeriesUint64 struct {
+	valFormatter dataframe.ValueToStringFormatter
+
+	lock     sync.RWMutex
+	name     string
+	values   []*uint64
+	nilCount int
+}
+
+// NewSeriesUint64 creates a new series with the underlying type as uint64
+func NewSeriesUint64(name string, init *dataframe.SeriesInit, vals ...interface{}) *SeriesUint64 {
+	s := &SeriesUint64{
+		name:     name,
+		values:   []*uint64{},
+		nilCount: 0,
+	}
+
+	var (
+		size     int
+		capacity int
+	)
+
+	if init != nil {
+		size = init.Size
+		capacity = init.Capacity
+		if size > capacity {
+			capacity = size
+		}
+	}
+
+	s.values = make([]*uint64, size, capacity)
+	s.valFormatter = dataframe.DefaultValueFormatter
+
+	for idx, v := range vals {
+		val := s.valToPointer(v)
+		if val == nil {
+			s.nilCount++
+		}
+
+		if idx < size {
+			s.values[idx] = val
+		} else {
+			s.values = append(s.values, val)
+		}
+	}
+
+	if len(vals) < size {
+		s.nilCount = s.nilCount + size - len(vals)
+	}
+
+	return s
+}
+
+// Name returns the series name.
+func (s *SeriesUint64) Name() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.name
+}
+
+// Rename renames the series.
+func (s *SeriesUint64) Rename(n string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.name = n
+}
+
+// Type returns the type of data the series holds.
+func (s *SeriesUint64) Type() string {
+	return "uint64"
+}
+
+// NRows returns how many rows the series contains.
+func (s *SeriesUint64) NRows(options ...dataframe.Options) int {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	return len(s.values)
+}
+
+// Value returns the value of a particular row.
+// The return value could be nil or the concrete type
+// the data type held by the series.
+// Pointers are never returned.
+func (s *SeriesUint64) Value(row int, options ...dataframe.Options) interface{} {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	val := s.values[row]
+	if val == nil {
+		return nil
+	}
+	return *val
+}
+
+// ValueString returns a string representation of a
+// particular row. The string representation is defined
+// by the function set in SetValueToStringFormatter.
+// By default, a nil value is returned as "NaN".
+func (s *SeriesUint64) ValueString(row int, options ...dataframe.Options) string {
+	return s.valFormatter(s.Value(row, options...))
+}
+
+// Prepend is used to set a value to the beginning of the
+// series. val can be a concrete data type or nil. Nil
+// represents the absence of a value.
+func (s *SeriesUint64) Prepend(val interface{}, options ...dataframe.Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	// See: https://stackoverflow.com/questions/41914386/what-is-the-mechanism-of-using-append-to-prepend-in-go
+
+	if cap(s.values) > len(s.values) {
+		// There is already extra capacity so copy current values by 1 spot
+		s.values = s.values[:len(s.values)+1]
+		copy(s.values[1:], s.values)
+		s.values[0] = s.valToPointer(val)
+		if s.values[0] == nil {
+			s.nilCount++
+		}
+		return
+	}
+
+	// No room, new slice needs to be allocated:
+	s.insert(0, val)
+}
+
+// Append is used to set a value to the end of the series.
+// val can be a concrete data type or nil. Nil represents
+// the absence of a value.
+func (s *SeriesUint64) Append(val interface{}, options ...dataframe.Options) int {
+	var locked bool
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		locked = true
+	}
+
+	row := s.NRows(dataframe.Options{DontLock: locked})
+	s.insert(row, val)
+	return row
+}
+
+// Insert is used to set a value at an arbitrary row in
+// the series. All existing values from that row onwards
+// are shifted by 1. val can be a concrete data type or nil.
+// Nil represents the absence of a value.
+func (s *SeriesUint64) Insert(row int, val interface{}, options ...dataframe.Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	s.insert(row, val)
+}
+
+func (s *SeriesUint64) insert(row int, val interface{}) {
+	switch V := val.(type) {
+	case []uint64:
+		var vals []*uint64
+		for _, v := range V {
+			v := v
+			vals = append(vals, &v)
+		}
+		s.values = append(s.values[:row], append(vals, s.values[row:]...)...)
+		return
+	case []*uint64:
+		for _, v := range V {
+			if v == nil {
+				s.nilCount++
+			}
+		}
+		s.values = append(s.values[:row], append(V, s.values[row:]...)...)
+		return
+	}
+
+	v := s.valToPointer(val) // Convert first so a panic leaves the series unmodified
+	if v == nil {
+		s.nilCount++
+	}
+
+	s.values = append(s.values, nil)
+	copy(s.values[row+1:], s.values[row:])
+
+	s.values[row] = v
+}
+
+// Remove is used to delete the value of a particular row.
+func (s *SeriesUint64) Remove(row int, options ...dataframe.Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	if s.values[row] == nil {
+		s.nilCount--
+	}
+
+	s.values = append(s.values[:row], s.values[row+1:]...)
+}
+
+// TrimCapacity will reallocate the underlying slice so that its capacity
+// matches the number of rows. Removing rows does not release the memory
+// held by the underlying slice, so this should be called after many rows
+// have been removed.
+func (s *SeriesUint64) TrimCapacity(options ...dataframe.Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	if cap(s.values) == len(s.values) {
+		return
+	}
+
+	newSlice := make([]*uint64, len(s.values))
+	copy(newSlice, s.values)
+	s.values = newSlice
+}
+
+// Update is used to update the value of a particular row.
+// val can be a concrete data type or nil. Nil represents
+// the absence of a value.
+func (s *SeriesUint64) Update(row int, val interface{}, options ...dataframe.Options) {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	newVal := s.valToPointer(val)
+
+	if s.values[row] == nil && newVal != nil {
+		s.nilCount--
+	} else if s.values[row] != nil && newVal == nil {
+		s.nilCount++
+	}
+
+	s.values[row] = newVal
+}
+
+func (s *SeriesUint64) valToPointer(v interface{}) *uint64 {
+	switch val := v.(type) {
+	case nil:
+		return nil
+	case *uint64:
+		if val == nil {
+			return nil
+		}
+		return &[]uint64{*val}[0]
+	case uint64:
+		return &val
+	default:
+		str := fmt.Sprintf("%v", v)
+		i, err := strconv.ParseUint(str, 10, 64)
+		if err != nil {
+			if _, err := strconv.ParseInt(str, 10, 64); err == nil {
+				panic(fmt.Errorf("negative value %s can't be stored in SeriesUint64", str))
+			}
+			_ = v.(uint64) // Intentionally panic
+		}
+		return &i
+	}
+}
+
+// SetValueToStringFormatter is used to set a function
+// to convert the value of a particular row to a string
+// representation.
+func (s *SeriesUint64) SetValueToStringFormatter(f dataframe.ValueToStringFormatter) {
+	if f == nil {
+		s.valFormatter = dataframe.DefaultValueFormatter
+		return
+	}
+	s.valFormatter = f
+}
+
+// Swap is used to swap 2 values based on their row position.
+func (s *SeriesUint64) Swap(row1, row2 int, options ...dataframe.Options) {
+	if row1 == row2 {
+		return
+	}
+
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	}
+
+	s.values[row1], s.values[row2] = s.values[row2], s.values[row1]
+}
+
+// IsEqualFunc returns true if a is equal to b.
+func (s *SeriesUint64) IsEqualFunc(a, b interface{}) bool {
+
+	if a == nil {
+		if b == nil {
+			return true
+		}
+		return false
+	}
+
+	if b == nil {
+		return false
+	}
+	t1 := a.(uint64)
+	t2 := b.(uint64)
+
+	return t1 == t2
+}
+
+// IsLessThanFunc returns true if a is less than b.
+func (s *SeriesUint64) IsLessThanFunc(a, b interface{}) bool {
+
+	if a == nil {
+		if b == nil {
+			return true
+		}
+		return true
+	}
+
+	if b == nil {
+		return false
+	}
+	t1 := a.(uint64)
+	t2 := b.(uint64)
+
+	return t1 < t2
+}
+
+// Sort will sort the series.
+func (s *SeriesUint64) Sort(options ...dataframe.Options) {
+
+	var sortDesc bool
+
+	if len(options) == 0 {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+	} else {
+		if !options[0].DontLock {
+			s.lock.Lock()
+			defer s.lock.Unlock()
+		}
+		sortDesc = options[0].SortDesc
+	}
+
+	sort.SliceStable(s.values, func(i, j int) (ret bool) {
+		defer func() {
+			if sortDesc {
+				ret = !ret
+			}
+		}()
+
+		if s.values[i] == nil {
+			if s.values[j] == nil {
+				// both are nil
+				return true
+			}
+			return true
+		}
+
+		if s.values[j] == nil {
+			// i has value and j is nil
+			return false
+		}
+		// Both are not nil
+		ti := *s.values[i]
+		tj := *s.values[j]
+
+		return ti < tj
+	})
+}
+
+// Lock will lock the Series allowing you to directly manipulate
+// the underlying slice with confidence.
+func (s *SeriesUint64) Lock() {
+	s.lock.Lock()
+}
+
+// Unlock will unlock the Series that was previously locked.
+func (s *SeriesUint64) Unlock() {
+	s.lock.Unlock()
+}
+
+// Copy will create a new copy of the series.
+// It is recommended that you lock the Series before attempting
+// to Copy.
+func (s *SeriesUint64) Copy(r ...dataframe.Range) dataframe.Series {
+
+	if len(s.values) == 0 {
+		return &SeriesUint64{
+			valFormatter: s.valFormatter,
+			name:         s.name,
+			values:       []*uint64{},
+			nilCount:     s.nilCount,
+		}
+	}
+
+	if len(r) == 0 {
+		r = append(r, dataframe.Range{})
+	}
+
+	start, end, err := r[0].Limits(len(s.values))
+	if err != nil {
+		panic(err)
+	}
+
+	// Copy slice
+	x := s.values[start : end+1]
+	newSlice := append(x[:0:0], x...)
+
+	var nilCount int
+	for _, v := range newSlice {
+		if v == nil {
+			nilCount++
+		}
+	}
+
+	return &SeriesUint64{
+		valFormatter: s.valFormatter,
+		name:         s.name,
+		values:       newSlice,
+		nilCount:     nilCount,
+	}
+}
+
+// Table will produce the Series in a table.
+func (s *SeriesUint64) Table(r ...dataframe.Range) string {
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if len(r) == 0 {
+		r = append(r, dataframe.Range{})
+	}
+
+	data := [][]string{}
+
+	headers := []string{"", s.name} // row header is blank
+	footers := []string{fmt.Sprintf("%dx%d", len(s.values), 1), s.Type()}
+
+	if len(s.values) > 0 {
+
+		start, end, err := r[0].Limits(len(s.values))
+		if err != nil {
+			panic(err)
+		}
+
+		for row := start; row <= end; row++ {
+			sVals := []string{fmt.Sprintf("%d:", row), s.ValueString(row, dataframe.Options{true, false})}
+			data = append(data, sVals)
+		}
+
+	}
+
+	var buf bytes.Buffer
+
+	table := tablewriter.NewWriter(&buf)
+	table.SetHeader(headers)
+	for _, v := range data {
+		table.Append(v)
+	}
+	table.SetFooter(footers)
+	table.SetAlignment(tablewriter.ALIGN_CENTER)
+
+	table.Render()
+
+	return buf.String()
+}
+
+// String implements Stringer interface.
+func (s *SeriesUint64) String() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	count := len(s.values)
+
+	out := "[ "
+
+	if count > 6 {
+		idx := []int{0, 1, 2, count - 3, count - 2, count - 1}
+		for j, row := range idx {
+			if j == 3 {
+				out = out + "... "
+			}
+			out = out + s.ValueString(row, dataframe.Options{true, false}) + " "
+		}
+		return out + "]"
+	}
+
+	for row := range s.values {
+		out = out + s.ValueString(row, dataframe.Options{true, false}) + " "
+	}
+	return out + "]"
+}
+
+// ContainsNil will return whether or not the series contains any nil values.
+func (s *SeriesUint64) ContainsNil() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.nilCount > 0
+}
+
+// IsNull returns a mask containing true for each row with a nil value and false otherwise.
+// The mask can be combined with other masks and used with dataframe.FilterByMask.
+func (s *SeriesUint64) IsNull(options ...dataframe.Options) *dataframe.SeriesBool {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	mask := dataframe.NewSeriesBool(s.name, &dataframe.SeriesInit{Capacity: len(s.values)})
+	for _, v := range s.values {
+		mask.Append(v == nil)
+	}
+
+	return mask
+}
+
+// Validate recomputes the number of nil values and compares it against the
+// cached count, returning an error if they differ.
+// It is intended to be used as a self-check while debugging and in tests.
+func (s *SeriesUint64) Validate(options ...dataframe.Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	var nilCount int
+	for _, v := range s.values {
+		if v == nil {
+			nilCount++
+		}
+	}
+
+	if nilCount != s.nilCount {
+		return fmt.Errorf("nil count mismatch: cached: %d actual: %d", s.nilCount, nilCount)
+	}
+
+	return nil
+}
+
+// ForEach calls fn for each row of the series in order. val is nil for missing values.
+// Iteration stops as soon as fn returns an error, which is then returned by ForEach.
+// The series is read locked once for the duration of the iteration, so fn must not
+// modify the series.
+func (s *SeriesUint64) ForEach(fn func(row int, val interface{}) error, options ...dataframe.Options) error {
+	if len(options) == 0 || (len(options) > 0 && !options[0].DontLock) {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+	}
+
+	for row := range s.values {
+		if err := fn(row, s.Value(row, dataframe.Options{true, false})); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}